@@ -1,14 +1,20 @@
 package aws
 
 import (
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -148,6 +154,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sso"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -165,11 +172,26 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/creds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/endpointvariant"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/readcache"
+)
+
+const (
+	// RetryModeAdaptive additionally rate limits requests to services
+	// throttling requests.
+	RetryModeAdaptive = "adaptive"
+	// RetryModeStandard retries requests with the SDK default retryer.
+	RetryModeStandard = "standard"
 )
 
 type Config struct {
 	AccessKey     string
+	ApiTraceFile  string
 	SecretKey     string
 	CredsFilename string
 	Profile       string
@@ -186,12 +208,27 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	// AssumeRoleChain contains the roles assumed after the role above
+	AssumeRoleChain   []*creds.AssumeRole
+	CredentialProcess string
+	SSO               *creds.SSO
+	WebIdentity       *creds.WebIdentity
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	CustomCABundle             string
+	DefaultTagsConfig          *keyvaluetags.DefaultConfig
+	EC2MetadataServiceEndpoint string
+	Endpoints                  map[string]string
+	HTTPProxy                  string
+	IgnoreTagsConfig           *keyvaluetags.IgnoreConfig
+	Insecure                   bool
+	RetryMode                  string
+
+	RateLimitsConfig   *ratelimit.Config
+	ReadCache          bool
+	RequiredTagsConfig *keyvaluetags.RequiredConfig
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -199,6 +236,8 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+	UseDualStackEndpoint    bool
+	UseFIPSEndpoint         bool
 
 	terraformVersion string
 }
@@ -240,6 +279,7 @@ type AWSClient struct {
 	codestarnotificationsconn           *codestarnotifications.CodeStarNotifications
 	cognitoconn                         *cognitoidentity.CognitoIdentity
 	cognitoidpconn                      *cognitoidentityprovider.CognitoIdentityProvider
+	config                              *Config
 	configconn                          *configservice.ConfigService
 	connectconn                         *connect.Connect
 	costandusagereportconn              *costandusagereportservice.CostandUsageReportService
@@ -247,6 +287,7 @@ type AWSClient struct {
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
 	daxconn                             *dax.DAX
+	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
@@ -326,7 +367,10 @@ type AWSClient struct {
 	ramconn                             *ram.RAM
 	rdsconn                             *rds.RDS
 	redshiftconn                        *redshift.Redshift
+	readCache                           *readcache.Cache
 	region                              string
+	regionalClients                     *regionalClients
+	RequiredTagsConfig                  *keyvaluetags.RequiredConfig
 	resourcegroupsconn                  *resourcegroups.ResourceGroups
 	resourcegroupstaggingapiconn        *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	route53domainsconn                  *route53domains.Route53Domains
@@ -343,6 +387,7 @@ type AWSClient struct {
 	serverlessapplicationrepositoryconn *serverlessapplicationrepository.ServerlessApplicationRepository
 	servicequotasconn                   *servicequotas.ServiceQuotas
 	sesconn                             *ses.SES
+	session                             *session.Session
 	sfnconn                             *sfn.SFN
 	shieldconn                          *shield.Shield
 	signerconn                          *signer.Signer
@@ -392,6 +437,10 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	if err := c.setHTTPEnvironment(); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
 	awsbaseConfig := &awsbase.Config{
 		AccessKey:                   c.AccessKey,
 		AssumeRoleARN:               c.AssumeRoleARN,
@@ -425,11 +474,83 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	if c.UseFIPSEndpoint || c.UseDualStackEndpoint {
+		var err error
+
+		// aws-sdk-go-base only supports overriding the STS and IAM endpoints
+		if awsbaseConfig.StsEndpoint, err = c.variantEndpoint(sts.EndpointsID, awsbaseConfig.StsEndpoint); err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		if awsbaseConfig.IamEndpoint, err = c.variantEndpoint(iam.EndpointsID, awsbaseConfig.IamEndpoint); err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	baseCreds, err := c.baseCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	var assumeRoles []*creds.AssumeRole
+
+	if baseCreds != nil {
+		value, err := baseCreds.Get()
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: error retrieving credentials: %w", err)
+		}
+
+		// aws-sdk-go-base validates these static credentials, the session
+		// credentials are replaced with the refreshing ones below
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.Token = value.SessionToken
+
+		if c.AssumeRoleARN != "" {
+			assumeRoles = append(assumeRoles, c.assumeRole())
+
+			awsbaseConfig.AssumeRoleARN = ""
+		}
+	}
+
+	assumeRoles = append(assumeRoles, c.AssumeRoleChain...)
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if c.UseFIPSEndpoint || c.UseDualStackEndpoint {
+		// Endpoints configured in the endpoints block take precedence
+		sess.Config.EndpointResolver = c.endpointResolver()
+	}
+
+	if baseCreds != nil || len(assumeRoles) > 0 {
+		sessCreds := sess.Config.Credentials
+
+		if baseCreds != nil {
+			sessCreds = baseCreds
+		}
+
+		for _, assumeRole := range assumeRoles {
+			log.Printf("[INFO] Assuming IAM Role (%s)", assumeRole.RoleARN)
+
+			sessCreds = creds.AssumeRoleCredentials(sess, sessCreds, c.Endpoints["sts"], assumeRole)
+		}
+
+		sess.Config.Credentials = sessCreds
+
+		if len(assumeRoles) > 0 && !c.SkipRequestingAccountId {
+			iamconn := iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])}))
+			stsconn := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+
+			accountID, partition, err = awsbase.GetAccountIDAndPartition(iamconn, stsconn, "")
+			if err != nil {
+				return nil, fmt.Errorf("error configuring Terraform AWS Provider: error determining account ID of assumed IAM Role: %w", err)
+			}
+		}
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -438,6 +559,188 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	// Installed on the session so every service client copies the handlers
+	ratelimit.InstallHandlers(&sess.Handlers, c.rateLimitsConfig())
+
+	if c.ApiTraceFile != "" {
+		tracer, err := apitrace.Open(c.ApiTraceFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error opening API trace file (%s): %w", c.ApiTraceFile, err)
+		}
+
+		tracer.InstallHandlers(&sess.Handlers)
+	}
+
+	var readCache *readcache.Cache
+
+	if c.ReadCache {
+		readCache = readcache.New()
+		readCache.InstallHandlers(&sess.Handlers)
+	}
+
+	client := c.newAWSClient(sess, accountID, partition)
+	client.readCache = readCache
+	client.regionalClients = &regionalClients{
+		byRegion: map[string]*AWSClient{c.Region: client},
+	}
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.supportedplatforms = supportedPlatforms
+		}
+	}
+
+	return client, nil
+}
+
+// setHTTPEnvironment exports the custom CA bundle, HTTP proxy and EC2 metadata
+// service endpoint settings to the environment, where the AWS SDK reads them
+// when creating the sessions and HTTP clients of aws-sdk-go-base and the
+// provider. Each provider configuration runs in its own process.
+func (c *Config) setHTTPEnvironment() error {
+	if c.CustomCABundle != "" {
+		path, err := homedir.Expand(c.CustomCABundle)
+		if err != nil {
+			return fmt.Errorf("error expanding custom CA bundle path (%s): %w", c.CustomCABundle, err)
+		}
+
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading custom CA bundle (%s): %w", path, err)
+		}
+
+		if !x509.NewCertPool().AppendCertsFromPEM(pem) {
+			return fmt.Errorf("error reading custom CA bundle (%s): no PEM encoded certificates found", path)
+		}
+
+		os.Setenv("AWS_CA_BUNDLE", path)
+	}
+
+	if c.EC2MetadataServiceEndpoint != "" {
+		os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", c.EC2MetadataServiceEndpoint)
+	}
+
+	if c.HTTPProxy != "" {
+		os.Setenv("HTTP_PROXY", c.HTTPProxy)
+		os.Setenv("HTTPS_PROXY", c.HTTPProxy)
+	}
+
+	return nil
+}
+
+// rateLimitsConfig returns the client-side rate limits, including adaptive
+// rate limiting of all services for the adaptive retry mode.
+func (c *Config) rateLimitsConfig() *ratelimit.Config {
+	if c.RetryMode != RetryModeAdaptive {
+		return c.RateLimitsConfig
+	}
+
+	config := &ratelimit.Config{}
+
+	if c.RateLimitsConfig != nil {
+		*config = *c.RateLimitsConfig
+	}
+
+	config.AdaptiveAll = true
+
+	return config
+}
+
+// baseCredentials returns the credentials from the credential_process, sso or
+// assume_role_with_web_identity settings, nil if none are configured.
+func (c *Config) baseCredentials() (*credentials.Credentials, error) {
+	switch {
+	case c.CredentialProcess != "":
+		return processcreds.NewCredentials(c.CredentialProcess), nil
+	case c.SSO != nil:
+		region := c.SSO.Region
+		if region == "" {
+			region = c.Region
+		}
+
+		sess, err := c.anonymousSession(region)
+		if err != nil {
+			return nil, err
+		}
+
+		return creds.SSOCredentials(sso.New(sess), c.SSO), nil
+	case c.WebIdentity != nil:
+		sess, err := c.anonymousSession(c.Region)
+		if err != nil {
+			return nil, err
+		}
+
+		return creds.WebIdentityCredentials(sts.New(sess, &aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}), c.WebIdentity), nil
+	}
+
+	return nil, nil
+}
+
+// anonymousSession returns a session without credentials for the unsigned
+// requests retrieving credentials.
+func (c *Config) anonymousSession(region string) (*session.Session, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.AnonymousCredentials,
+		EndpointResolver: c.endpointResolver(),
+		MaxRetries:       aws.Int(c.MaxRetries),
+		Region:           aws.String(region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating session: %w", err)
+	}
+
+	return sess, nil
+}
+
+// endpointResolver returns the resolver of the FIPS and/or dual-stack service
+// endpoints if enabled, the SDK default resolver otherwise.
+func (c *Config) endpointResolver() endpoints.Resolver {
+	if !c.UseFIPSEndpoint && !c.UseDualStackEndpoint {
+		return endpoints.DefaultResolver()
+	}
+
+	return endpoints.ResolverFunc(endpointvariant.NewResolver(c.UseFIPSEndpoint, c.UseDualStackEndpoint).EndpointFor)
+}
+
+// variantEndpoint returns the FIPS and/or dual-stack endpoint URL of the
+// service in the provider region, unless an endpoint is configured.
+func (c *Config) variantEndpoint(service, endpoint string) (string, error) {
+	if endpoint != "" {
+		return endpoint, nil
+	}
+
+	resolved, err := c.endpointResolver().EndpointFor(service, c.Region)
+	if err != nil {
+		return "", err
+	}
+
+	return resolved.URL, nil
+}
+
+// assumeRole returns the settings of the first IAM Role to assume.
+func (c *Config) assumeRole() *creds.AssumeRole {
+	return &creds.AssumeRole{
+		DurationSeconds:   c.AssumeRoleDurationSeconds,
+		ExternalID:        c.AssumeRoleExternalID,
+		Policy:            c.AssumeRolePolicy,
+		PolicyARNs:        c.AssumeRolePolicyARNs,
+		RoleARN:           c.AssumeRoleARN,
+		SessionName:       c.AssumeRoleSessionName,
+		Tags:              c.AssumeRoleTags,
+		TransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
+	}
+}
+
+// newAWSClient returns an AWSClient whose service clients are created from
+// the given session in the configured region.
+func (c *Config) newAWSClient(sess *session.Session, accountID, partition string) *AWSClient {
 	dnsSuffix := "amazonaws.com"
 	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
@@ -487,6 +790,7 @@ func (c *Config) Client() (interface{}, error) {
 		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
 		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
 		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
+		DefaultTagsConfig:                   c.DefaultTagsConfig,
 		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
 		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
 		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
@@ -497,7 +801,6 @@ func (c *Config) Client() (interface{}, error) {
 		dynamodbconn:                        dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])})),
 		ec2conn:                             ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])})),
 		ecrconn:                             ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])})),
-		ecrpublicconn:                       ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecrpublic"])})),
 		ecsconn:                             ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])})),
 		efsconn:                             efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])})),
 		eksconn:                             eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])})),
@@ -550,7 +853,6 @@ func (c *Config) Client() (interface{}, error) {
 		mwaaconn:                            mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mwaa"])})),
 		neptuneconn:                         neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])})),
 		networkfirewallconn:                 networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkfirewall"])})),
-		networkmanagerconn:                  networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkmanager"])})),
 		opsworksconn:                        opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])})),
 		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
 		outpostsconn:                        outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["outposts"])})),
@@ -564,9 +866,9 @@ func (c *Config) Client() (interface{}, error) {
 		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
 		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
 		region:                              c.Region,
+		RequiredTagsConfig:                  c.RequiredTagsConfig,
 		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
 		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
-		route53domainsconn:                  route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
 		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
 		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
 		s3outpostsconn:                      s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3outposts"])})),
@@ -602,12 +904,21 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	// "Global" services that require customizations
+	ecrpublicConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["ecrpublic"]),
+	}
 	globalAcceleratorConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["globalaccelerator"]),
 	}
+	networkmanagerConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["networkmanager"]),
+	}
 	route53Config := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["route53"]),
 	}
+	route53domainsConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["route53domains"]),
+	}
 	shieldConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["shield"]),
 	}
@@ -626,8 +937,11 @@ func (c *Config) Client() (interface{}, error) {
 	// Force "global" services to correct regions
 	switch partition {
 	case endpoints.AwsPartitionID:
+		ecrpublicConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
+		networkmanagerConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
+		route53domainsConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
 	case endpoints.AwsCnPartitionID:
 		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
@@ -637,11 +951,15 @@ func (c *Config) Client() (interface{}, error) {
 		}
 		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
 	case endpoints.AwsUsGovPartitionID:
+		networkmanagerConfig.Region = aws.String(endpoints.UsGovWest1RegionID)
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
+	client.ecrpublicconn = ecrpublic.New(sess.Copy(ecrpublicConfig))
 	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
+	client.networkmanagerconn = networkmanager.New(sess.Copy(networkmanagerConfig))
 	client.r53conn = route53.New(sess.Copy(route53Config))
+	client.route53domainsconn = route53domains.New(sess.Copy(route53domainsConfig))
 	client.shieldconn = shield.New(sess.Copy(shieldConfig))
 
 	client.apigatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
@@ -783,24 +1101,16 @@ func (c *Config) Client() (interface{}, error) {
 			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
-			if isAWSErr(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
 		}
 	})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
-		}
-	}
+	client.config = c
+	client.session = sess
 
-	return client, nil
+	return client
 }
 
 func hasEc2Classic(platforms []string) bool {
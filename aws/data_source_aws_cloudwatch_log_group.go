@@ -32,14 +32,19 @@ func dataSourceAwsCloudwatchLogGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchemaComputed(),
+			"region": dataSourceRegionSchema(),
+			"tags":   tagsSchemaComputed(),
 		},
 	}
 }
 
 func dataSourceAwsCloudwatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	logGroup, err := lookupCloudWatchLogGroup(conn, name)
@@ -50,11 +55,12 @@ func dataSourceAwsCloudwatchLogGroupRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("No log group named %s found\n", name)
 	}
 
-	d.SetId(name)
+	d.SetId(regionalResourceID(name, client.region, meta))
 	d.Set("arn", logGroup.Arn)
 	d.Set("creation_time", logGroup.CreationTime)
 	d.Set("retention_in_days", logGroup.RetentionInDays)
 	d.Set("kms_key_id", logGroup.KmsKeyId)
+	d.Set("region", client.region)
 
 	tags, err := keyvaluetags.CloudwatchlogsListTags(conn, name)
 
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+)
+
+func dataSourceAwsTimestreamWriteDatabase() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsTimestreamWriteDatabaseRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsTimestreamWriteDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("database_name").(string)
+	database, err := finder.DatabaseByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading Timestream Database (%s): %w", name, err)
+	}
+
+	arn := aws.StringValue(database.Arn)
+	d.SetId(aws.StringValue(database.DatabaseName))
+	d.Set("arn", arn)
+	d.Set("database_name", database.DatabaseName)
+	d.Set("kms_key_id", database.KmsKeyId)
+	d.Set("table_count", database.TableCount)
+
+	tags, err := keyvaluetags.TimestreamwriteListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Database (%s): %w", name, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
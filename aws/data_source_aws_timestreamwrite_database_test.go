@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSTimestreamWriteDatabaseDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_database.test"
+	dataSourceName := "data.aws_timestreamwrite_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(timestreamwrite.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteDatabaseDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "database_name", resourceName, "database_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "table_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSTimestreamWriteDatabaseDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_timestreamwrite_database" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+}
+`, rName)
+}
@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+)
+
+func dataSourceAwsTimestreamWriteTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsTimestreamWriteTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"retention_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"magnetic_store_retention_period_in_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_store_retention_period_in_hours": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"table_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsTimestreamWriteTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	databaseName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	table, err := finder.TableByName(conn, databaseName, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error reading Timestream Table (%s) in Database (%s): %w", tableName, databaseName, err)
+	}
+
+	arn := aws.StringValue(table.Arn)
+	d.SetId(fmt.Sprintf("%s:%s", tableName, databaseName))
+	d.Set("arn", arn)
+	d.Set("database_name", table.DatabaseName)
+
+	if err := d.Set("retention_properties", flattenTimestreamWriteRetentionProperties(table.RetentionProperties)); err != nil {
+		return fmt.Errorf("error setting retention_properties: %w", err)
+	}
+
+	d.Set("table_name", table.TableName)
+	d.Set("table_status", table.TableStatus)
+
+	tags, err := keyvaluetags.TimestreamwriteListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSTimestreamWriteTableDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+	dataSourceName := "data.aws_timestreamwrite_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(timestreamwrite.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "database_name", resourceName, "database_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "retention_properties.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "retention_properties.0.magnetic_store_retention_period_in_days", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "retention_properties.0.memory_store_retention_period_in_hours", "12"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_name", resourceName, "table_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "table_status", timestreamwrite.TableStatusActive),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSTimestreamWriteTableDataSourceConfig(rName string) string {
+	return composeConfig(testAccAWSTimestreamWriteTableConfigRetentionProperties(rName, 30, 12), `
+data "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_table.test.database_name
+  table_name    = aws_timestreamwrite_table.test.table_name
+}
+`)
+}
@@ -0,0 +1,148 @@
+package apitrace
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ErrorCodeUnknown is recorded for request errors without an AWS error code.
+const ErrorCodeUnknown = "Unknown"
+
+// Record is a single line of the API trace.
+type Record struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	DurationMs   int64     `json:"duration_ms"`
+	RetryCount   int       `json:"retry_count"`
+	HTTPStatus   int       `json:"http_status,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+}
+
+// Tracer writes a JSON-lines trace of completed AWS API requests to a file.
+type Tracer struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+type resourceContextKey struct{}
+
+type resourceContextValue struct {
+	resourceType string
+	id           string
+}
+
+var tracers = struct {
+	sync.Mutex
+	byPath map[string]*Tracer
+}{
+	byPath: make(map[string]*Tracer),
+}
+
+// Open returns the Tracer appending to the file at the given path,
+// creating the file if necessary. Tracers are shared by path so that
+// multiple provider configurations do not interleave partial lines.
+func Open(path string) (*Tracer, error) {
+	tracers.Lock()
+	defer tracers.Unlock()
+
+	if tracer, ok := tracers.byPath[path]; ok {
+		return tracer, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := &Tracer{
+		encoder: json.NewEncoder(f),
+	}
+	tracers.byPath[path] = tracer
+
+	return tracer, nil
+}
+
+// WithResource returns a copy of the context carrying the resource type and ID,
+// which are added to the trace of any API request made with the context.
+func WithResource(ctx context.Context, resourceType, id string) context.Context {
+	return context.WithValue(ctx, resourceContextKey{}, resourceContextValue{
+		resourceType: resourceType,
+		id:           id,
+	})
+}
+
+// InstallHandlers adds the tracing handler to the given handler list.
+// Clients created from a session copy its handlers, so this must be called
+// before any service clients are created.
+func (t *Tracer) InstallHandlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.apitrace.Write",
+		Fn:   t.write,
+	})
+}
+
+func (t *Tracer) write(r *request.Request) {
+	record := NewRecord(r)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.encoder.Encode(record); err != nil {
+		log.Printf("[WARN] Unable to write API trace record for %s/%s: %s", record.Service, record.Operation, err)
+	}
+}
+
+// NewRecord returns the trace record for the given completed request.
+func NewRecord(r *request.Request) *Record {
+	now := time.Now()
+
+	record := &Record{
+		Time:       now.UTC(),
+		Service:    strings.ToLower(strings.ReplaceAll(r.ClientInfo.ServiceID, " ", "")),
+		DurationMs: now.Sub(r.Time).Milliseconds(),
+		RetryCount: r.RetryCount,
+		RequestID:  r.RequestID,
+	}
+
+	if r.Operation != nil {
+		record.Operation = r.Operation.Name
+	}
+
+	if r.Config.Region != nil {
+		record.Region = aws.StringValue(r.Config.Region)
+	}
+
+	if r.HTTPResponse != nil {
+		record.HTTPStatus = r.HTTPResponse.StatusCode
+	}
+
+	if v, ok := r.Context().Value(resourceContextKey{}).(resourceContextValue); ok {
+		record.ResourceType = v.resourceType
+		record.ResourceID = v.id
+	}
+
+	if r.Error != nil {
+		if awsErr, ok := r.Error.(awserr.Error); ok {
+			record.ErrorCode = awsErr.Code()
+		} else {
+			record.ErrorCode = ErrorCodeUnknown
+		}
+	}
+
+	return record
+}
@@ -0,0 +1,147 @@
+package apitrace
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func testRequest(ctx context.Context, err error) *request.Request {
+	r := request.New(
+		aws.Config{Region: aws.String("us-west-2")},
+		metadata.ClientInfo{ServiceID: "Route 53"},
+		request.Handlers{},
+		nil,
+		&request.Operation{Name: "ListHostedZones"},
+		nil,
+		nil,
+	)
+	r.SetContext(ctx)
+	r.Time = time.Now().Add(-2 * time.Second)
+	r.RetryCount = 3
+	r.Error = err
+
+	return r
+}
+
+func TestNewRecord(t *testing.T) {
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		err          error
+		wantCode     string
+		wantResource string
+	}{
+		{
+			name: "success",
+			ctx:  context.Background(),
+		},
+		{
+			name:     "aws error",
+			ctx:      context.Background(),
+			err:      awserr.New("Throttling", "Rate exceeded", nil),
+			wantCode: "Throttling",
+		},
+		{
+			name:     "other error",
+			ctx:      context.Background(),
+			err:      errors.New("test"),
+			wantCode: ErrorCodeUnknown,
+		},
+		{
+			name:         "resource",
+			ctx:          WithResource(context.Background(), "aws_route53_zone", "Z123"),
+			wantResource: "aws_route53_zone",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := NewRecord(testRequest(testCase.ctx, testCase.err))
+
+			if got.Service != "route53" {
+				t.Errorf("got service %q; want %q", got.Service, "route53")
+			}
+
+			if got.Operation != "ListHostedZones" {
+				t.Errorf("got operation %q; want %q", got.Operation, "ListHostedZones")
+			}
+
+			if got.Region != "us-west-2" {
+				t.Errorf("got region %q; want %q", got.Region, "us-west-2")
+			}
+
+			if got.RetryCount != 3 {
+				t.Errorf("got retry count %d; want %d", got.RetryCount, 3)
+			}
+
+			if got.DurationMs < 2000 {
+				t.Errorf("got duration %dms; want at least 2000ms", got.DurationMs)
+			}
+
+			if got.ErrorCode != testCase.wantCode {
+				t.Errorf("got error code %q; want %q", got.ErrorCode, testCase.wantCode)
+			}
+
+			if got.ResourceType != testCase.wantResource {
+				t.Errorf("got resource type %q; want %q", got.ResourceType, testCase.wantResource)
+			}
+		})
+	}
+}
+
+func TestTracerWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracer, err := Open(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error opening trace file: %s", err)
+	}
+
+	if again, _ := Open(path); again != tracer {
+		t.Error("expected tracer to be shared for the same path")
+	}
+
+	tracer.write(testRequest(context.Background(), nil))
+	tracer.write(testRequest(context.Background(), awserr.New("Throttling", "Rate exceeded", nil)))
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error reading trace file: %s", err)
+	}
+
+	defer f.Close()
+
+	var records []*Record
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		record := &Record{}
+
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatalf("unexpected error decoding trace line %q: %s", scanner.Text(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("got %d trace records; want 2", len(records))
+	}
+
+	if records[1].ErrorCode != "Throttling" {
+		t.Errorf("got error code %q; want %q", records[1].ErrorCode, "Throttling")
+	}
+}
@@ -0,0 +1,123 @@
+// Package creds contains the credential sources configurable in the provider
+// block in addition to those resolved by aws-sdk-go-base.
+package creds
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+// DefaultSessionName is the role session name used when none is configured.
+const DefaultSessionName = "terraform-provider-aws"
+
+// AssumeRole contains the settings of a single role assumption.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// WebIdentity contains the settings of a role assumption with an OIDC token.
+type WebIdentity struct {
+	DurationSeconds int
+	PolicyARNs      []string
+	RoleARN         string
+	SessionName     string
+	Token           string
+	TokenFile       string
+}
+
+// AssumeRoleCredentials returns refreshing credentials for the role, assumed
+// with the given credentials. Calling it once per role in a list of roles
+// results in chained role assumption.
+func AssumeRoleCredentials(p client.ConfigProvider, creds *credentials.Credentials, stsEndpoint string, role *AssumeRole) *credentials.Credentials {
+	conn := sts.New(p, &aws.Config{
+		Credentials: creds,
+		Endpoint:    aws.String(stsEndpoint),
+	})
+
+	return stscreds.NewCredentialsWithClient(conn, role.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = sessionName(role.SessionName)
+
+		if role.DurationSeconds > 0 {
+			p.Duration = time.Duration(role.DurationSeconds) * time.Second
+		}
+
+		if role.ExternalID != "" {
+			p.ExternalID = aws.String(role.ExternalID)
+		}
+
+		if role.Policy != "" {
+			p.Policy = aws.String(role.Policy)
+		}
+
+		p.PolicyArns = policyDescriptors(role.PolicyARNs)
+
+		for k, v := range role.Tags {
+			p.Tags = append(p.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		p.TransitiveTagKeys = aws.StringSlice(role.TransitiveTagKeys)
+	})
+}
+
+// WebIdentityCredentials returns refreshing credentials for the role, assumed
+// with the configured OIDC token or token file.
+func WebIdentityCredentials(conn stsiface.STSAPI, webIdentity *WebIdentity) *credentials.Credentials {
+	var fetcher stscreds.TokenFetcher = stscreds.FetchTokenPath(webIdentity.TokenFile)
+
+	if webIdentity.Token != "" {
+		fetcher = staticToken(webIdentity.Token)
+	}
+
+	p := stscreds.NewWebIdentityRoleProviderWithToken(conn, webIdentity.RoleARN, sessionName(webIdentity.SessionName), fetcher)
+
+	if webIdentity.DurationSeconds > 0 {
+		p.Duration = time.Duration(webIdentity.DurationSeconds) * time.Second
+	}
+
+	p.PolicyArns = policyDescriptors(webIdentity.PolicyARNs)
+
+	return credentials.NewCredentials(p)
+}
+
+// staticToken is a TokenFetcher returning a token configured inline.
+type staticToken string
+
+func (t staticToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+func sessionName(name string) string {
+	if name == "" {
+		return DefaultSessionName
+	}
+
+	return name
+}
+
+func policyDescriptors(arns []string) []*sts.PolicyDescriptorType {
+	var descriptors []*sts.PolicyDescriptorType
+
+	for _, arn := range arns {
+		descriptors = append(descriptors, &sts.PolicyDescriptorType{
+			Arn: aws.String(arn),
+		})
+	}
+
+	return descriptors
+}
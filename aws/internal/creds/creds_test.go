@@ -0,0 +1,117 @@
+package creds
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+type mockSTSClient struct {
+	stsiface.STSAPI
+
+	input *sts.AssumeRoleWithWebIdentityInput
+}
+
+func (m *mockSTSClient) AssumeRoleWithWebIdentityRequest(input *sts.AssumeRoleWithWebIdentityInput) (*request.Request, *sts.AssumeRoleWithWebIdentityOutput) {
+	m.input = input
+
+	output := &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyId:     aws.String("AKID"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+			SecretAccessKey: aws.String("SECRET"),
+			SessionToken:    aws.String("SESSION"),
+		},
+	}
+
+	return &request.Request{
+		Data:        output,
+		HTTPRequest: &http.Request{},
+	}, output
+}
+
+func TestWebIdentityCredentials(t *testing.T) {
+	tokenFile, err := ioutil.TempFile("", "web-identity-token")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer os.Remove(tokenFile.Name())
+
+	if _, err := tokenFile.WriteString("file-token"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tokenFile.Close()
+
+	testCases := []struct {
+		Name                string
+		WebIdentity         *WebIdentity
+		ExpectedSessionName string
+		ExpectedToken       string
+	}{
+		{
+			Name: "token",
+			WebIdentity: &WebIdentity{
+				RoleARN:     "arn:aws:iam::123456789012:role/test",
+				SessionName: "test",
+				Token:       "inline-token",
+			},
+			ExpectedSessionName: "test",
+			ExpectedToken:       "inline-token",
+		},
+		{
+			Name: "token file",
+			WebIdentity: &WebIdentity{
+				DurationSeconds: 3600,
+				PolicyARNs:      []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+				RoleARN:         "arn:aws:iam::123456789012:role/test",
+				TokenFile:       tokenFile.Name(),
+			},
+			ExpectedSessionName: DefaultSessionName,
+			ExpectedToken:       "file-token",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &mockSTSClient{}
+
+			value, err := WebIdentityCredentials(conn, testCase.WebIdentity).Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if value.AccessKeyID != "AKID" {
+				t.Errorf("got access key ID %q, expected %q", value.AccessKeyID, "AKID")
+			}
+
+			if got := aws.StringValue(conn.input.RoleSessionName); got != testCase.ExpectedSessionName {
+				t.Errorf("got session name %q, expected %q", got, testCase.ExpectedSessionName)
+			}
+
+			if got := aws.StringValue(conn.input.WebIdentityToken); got != testCase.ExpectedToken {
+				t.Errorf("got token %q, expected %q", got, testCase.ExpectedToken)
+			}
+
+			if got, expected := len(conn.input.PolicyArns), len(testCase.WebIdentity.PolicyARNs); got != expected {
+				t.Errorf("got %d policy ARNs, expected %d", got, expected)
+			}
+
+			if testCase.WebIdentity.DurationSeconds > 0 {
+				if got := aws.Int64Value(conn.input.DurationSeconds); got != int64(testCase.WebIdentity.DurationSeconds) {
+					t.Errorf("got duration %d, expected %d", got, testCase.WebIdentity.DurationSeconds)
+				}
+			}
+		})
+	}
+}
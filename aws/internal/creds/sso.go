@@ -0,0 +1,175 @@
+package creds
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
+)
+
+// SSOProviderName is the name of the AWS SSO credentials provider.
+const SSOProviderName = "SSOProvider"
+
+// SSO contains the settings of an AWS SSO permission set.
+type SSO struct {
+	AccountID string
+	// CacheDir is the directory holding the access tokens cached by
+	// "aws sso login", ~/.aws/sso/cache if empty.
+	CacheDir string
+	// Region is the region of the AWS SSO instance.
+	Region   string
+	RoleName string
+	StartURL string
+}
+
+// ssoCachedToken is the access token cached by the AWS CLI.
+type ssoCachedToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+// ssoExpiresAtLayouts are the layouts the AWS CLI has used for expiresAt.
+var ssoExpiresAtLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05UTC",
+}
+
+// SSOProvider retrieves credentials for an AWS SSO permission set with the
+// access token cached by "aws sso login".
+type SSOProvider struct {
+	credentials.Expiry
+
+	client ssoiface.SSOAPI
+	config *SSO
+	now    func() time.Time
+}
+
+// SSOCredentials returns refreshing credentials for the permission set.
+func SSOCredentials(conn ssoiface.SSOAPI, config *SSO) *credentials.Credentials {
+	return credentials.NewCredentials(NewSSOProvider(conn, config))
+}
+
+// NewSSOProvider returns a new SSOProvider.
+func NewSSOProvider(conn ssoiface.SSOAPI, config *SSO) *SSOProvider {
+	return &SSOProvider{
+		client: conn,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Retrieve retrieves role credentials from AWS SSO.
+func (p *SSOProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext retrieves role credentials from AWS SSO.
+func (p *SSOProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	token, err := p.cachedToken()
+
+	if err != nil {
+		return credentials.Value{ProviderName: SSOProviderName}, err
+	}
+
+	output, err := p.client.GetRoleCredentialsWithContext(ctx, &sso.GetRoleCredentialsInput{
+		AccessToken: aws.String(token),
+		AccountId:   aws.String(p.config.AccountID),
+		RoleName:    aws.String(p.config.RoleName),
+	})
+
+	if err != nil {
+		return credentials.Value{ProviderName: SSOProviderName}, fmt.Errorf("error getting AWS SSO role (%s) credentials for account (%s): %w", p.config.RoleName, p.config.AccountID, err)
+	}
+
+	if output == nil || output.RoleCredentials == nil {
+		return credentials.Value{ProviderName: SSOProviderName}, fmt.Errorf("error getting AWS SSO role (%s) credentials for account (%s): empty response", p.config.RoleName, p.config.AccountID)
+	}
+
+	roleCredentials := output.RoleCredentials
+
+	p.SetExpiration(time.Unix(0, aws.Int64Value(roleCredentials.Expiration)*int64(time.Millisecond)), 0)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(roleCredentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(roleCredentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(roleCredentials.SessionToken),
+		ProviderName:    SSOProviderName,
+	}, nil
+}
+
+// SSOCachedTokenFile returns the path of the access token cached by the AWS
+// CLI for the start URL.
+func SSOCachedTokenFile(cacheDir, startURL string) (string, error) {
+	if cacheDir == "" {
+		home, err := os.UserHomeDir()
+
+		if err != nil {
+			return "", fmt.Errorf("error determining AWS SSO cache directory: %w", err)
+		}
+
+		cacheDir = filepath.Join(home, ".aws", "sso", "cache")
+	}
+
+	hash := sha1.Sum([]byte(startURL))
+
+	return filepath.Join(cacheDir, hex.EncodeToString(hash[:])+".json"), nil
+}
+
+func (p *SSOProvider) cachedToken() (string, error) {
+	path, err := SSOCachedTokenFile(p.config.CacheDir, p.config.StartURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return "", fmt.Errorf("error reading AWS SSO access token for %s, run \"aws sso login\": %w", p.config.StartURL, err)
+	}
+
+	var token ssoCachedToken
+
+	if err := json.Unmarshal(b, &token); err != nil {
+		return "", fmt.Errorf("error parsing AWS SSO access token (%s): %w", path, err)
+	}
+
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("AWS SSO access token (%s) is empty, run \"aws sso login\"", path)
+	}
+
+	expiresAt, err := parseSSOExpiresAt(token.ExpiresAt)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing AWS SSO access token (%s) expiration: %w", path, err)
+	}
+
+	if !p.now().Before(expiresAt) {
+		return "", fmt.Errorf("AWS SSO access token for %s expired at %s, run \"aws sso login\"", p.config.StartURL, expiresAt.Format(time.RFC3339))
+	}
+
+	return token.AccessToken, nil
+}
+
+func parseSSOExpiresAt(s string) (time.Time, error) {
+	var err error
+
+	for _, layout := range ssoExpiresAtLayouts {
+		var t time.Time
+
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
@@ -0,0 +1,163 @@
+package creds
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
+)
+
+type mockSSOClient struct {
+	ssoiface.SSOAPI
+
+	input  *sso.GetRoleCredentialsInput
+	output *sso.GetRoleCredentialsOutput
+	err    error
+}
+
+func (m *mockSSOClient) GetRoleCredentialsWithContext(_ aws.Context, input *sso.GetRoleCredentialsInput, _ ...request.Option) (*sso.GetRoleCredentialsOutput, error) {
+	m.input = input
+
+	return m.output, m.err
+}
+
+func writeSSOCachedToken(t *testing.T, dir, startURL, content string) {
+	path, err := SSOCachedTokenFile(dir, startURL)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestSSOCachedTokenFile(t *testing.T) {
+	got, err := SSOCachedTokenFile("/cache", "https://my-sso-portal.awsapps.com/start")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// SHA-1 of the start URL, as used by the AWS CLI
+	expected := filepath.Join("/cache", "c7aaaf71fcc8777ae2475525ed049d39fe16c484.json")
+
+	if got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
+
+func TestSSOProviderRetrieve(t *testing.T) {
+	startURL := "https://my-sso-portal.awsapps.com/start"
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		Token         string
+		ClientErr     error
+		ExpectedError bool
+	}{
+		{
+			Name:  "valid token",
+			Token: `{"accessToken": "token", "expiresAt": "2021-01-01T08:00:00Z"}`,
+		},
+		{
+			Name:  "valid token legacy expiration",
+			Token: `{"accessToken": "token", "expiresAt": "2021-01-01T08:00:00UTC"}`,
+		},
+		{
+			Name:          "expired token",
+			Token:         `{"accessToken": "token", "expiresAt": "2020-12-31T23:00:00Z"}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "missing token",
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid token",
+			Token:         `{"accessToken": "token"`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "client error",
+			Token:         `{"accessToken": "token", "expiresAt": "2021-01-01T08:00:00Z"}`,
+			ClientErr:     errors.New("UnauthorizedException"),
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "sso-cache")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			defer os.RemoveAll(dir)
+
+			if testCase.Token != "" {
+				writeSSOCachedToken(t, dir, startURL, testCase.Token)
+			}
+
+			client := &mockSSOClient{
+				output: &sso.GetRoleCredentialsOutput{
+					RoleCredentials: &sso.RoleCredentials{
+						AccessKeyId:     aws.String("AKID"),
+						Expiration:      aws.Int64(now.Add(time.Hour).UnixNano() / int64(time.Millisecond)),
+						SecretAccessKey: aws.String("SECRET"),
+						SessionToken:    aws.String("SESSION"),
+					},
+				},
+				err: testCase.ClientErr,
+			}
+
+			p := NewSSOProvider(client, &SSO{
+				AccountID: "123456789012",
+				CacheDir:  dir,
+				RoleName:  "Admin",
+				StartURL:  startURL,
+			})
+			p.now = func() time.Time { return now }
+
+			value, err := p.Retrieve()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := aws.StringValue(client.input.AccessToken), "token"; got != expected {
+				t.Errorf("got access token %q, expected %q", got, expected)
+			}
+
+			if got, expected := aws.StringValue(client.input.AccountId), "123456789012"; got != expected {
+				t.Errorf("got account ID %q, expected %q", got, expected)
+			}
+
+			if value.AccessKeyID != "AKID" || value.SecretAccessKey != "SECRET" || value.SessionToken != "SESSION" {
+				t.Errorf("unexpected credentials: %#v", value)
+			}
+
+			if value.ProviderName != SSOProviderName {
+				t.Errorf("got provider name %q, expected %q", value.ProviderName, SSOProviderName)
+			}
+		})
+	}
+}
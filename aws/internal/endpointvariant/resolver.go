@@ -0,0 +1,163 @@
+// Package endpointvariant resolves the FIPS and dual-stack variants of the
+// AWS service endpoints modeled by the SDK.
+package endpointvariant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// globalFIPSEndpoints contains the FIPS endpoint hostnames of the services
+// that are not regionalized, which the SDK always resolves to the partition
+// endpoint.
+var globalFIPSEndpoints = map[string]map[string]string{
+	endpoints.AwsPartitionID: {
+		"iam":           "iam-fips.amazonaws.com",
+		"organizations": "organizations-fips.us-east-1.amazonaws.com",
+		"route53":       "route53-fips.amazonaws.com",
+		"shield":        "shield-fips.us-east-1.amazonaws.com",
+		"waf":           "waf-fips.amazonaws.com",
+	},
+	endpoints.AwsUsGovPartitionID: {
+		"iam":           "iam.us-gov.amazonaws.com",
+		"organizations": "organizations.us-gov-west-1.amazonaws.com",
+		"route53":       "route53.us-gov.amazonaws.com",
+	},
+}
+
+// Resolver resolves the FIPS and/or dual-stack variant of service endpoints.
+type Resolver struct {
+	partitions   []endpoints.Partition
+	useDualStack bool
+	useFIPS      bool
+}
+
+// NewResolver returns a Resolver using the SDK default partitions.
+func NewResolver(useFIPS, useDualStack bool) *Resolver {
+	return &Resolver{
+		partitions:   endpoints.DefaultPartitions(),
+		useDualStack: useDualStack,
+		useFIPS:      useFIPS,
+	}
+}
+
+// EndpointFor returns the endpoint variant of the service in the region.
+// An error is returned if the service has no such variant in the region.
+func (r *Resolver) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	partition, ok := endpoints.PartitionForRegion(r.partitions, region)
+
+	if !ok {
+		return endpoints.ResolvedEndpoint{}, fmt.Errorf("no partition found for region (%s)", region)
+	}
+
+	resolved, err := partition.EndpointFor(service, region, opts...)
+
+	if err != nil {
+		return resolved, err
+	}
+
+	if r.useFIPS {
+		fips, err := r.fipsEndpointFor(partition, service, region, resolved, opts...)
+
+		if err != nil {
+			return fips, err
+		}
+
+		resolved = fips
+	}
+
+	if r.useDualStack {
+		dualStack, err := r.dualStackEndpointFor(partition, service, region, resolved, opts...)
+
+		if err != nil {
+			return dualStack, err
+		}
+
+		resolved = dualStack
+	}
+
+	return resolved, nil
+}
+
+// fipsEndpointFor returns the FIPS endpoint of the service. The SDK models
+// FIPS endpoints as additional endpoints of the service, named for example
+// fips-us-east-1, us-east-1-fips or fips-aws-global, whose credential scope is
+// the region of the standard endpoint.
+func (r *Resolver) fipsEndpointFor(partition endpoints.Partition, service, region string, standard endpoints.ResolvedEndpoint, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	if strings.Contains(standard.URL, "-fips.") {
+		return standard, nil
+	}
+
+	if hostname, ok := globalFIPSEndpoints[partition.ID()][service]; ok {
+		resolved := standard
+		resolved.URL = "https://" + hostname
+
+		return resolved, nil
+	}
+
+	var ids []string
+
+	if s, ok := partition.Services()[service]; ok {
+		for id := range s.Endpoints() {
+			if strings.Contains(id, "fips") {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	sort.Strings(ids)
+
+	regionIDs := map[string]bool{
+		"fips-" + region:      true,
+		region + "-fips":      true,
+		"fips-prod-" + region: true,
+	}
+
+	// Endpoints named for the region first, then any with the same credential scope
+	for _, matchRegionID := range []bool{true, false} {
+		for _, id := range ids {
+			if regionIDs[id] != matchRegionID {
+				continue
+			}
+
+			resolved, err := partition.EndpointFor(service, id, opts...)
+
+			if err != nil {
+				continue
+			}
+
+			if !matchRegionID && resolved.SigningRegion != standard.SigningRegion {
+				continue
+			}
+
+			resolved.SigningRegion = standard.SigningRegion
+
+			return resolved, nil
+		}
+	}
+
+	return endpoints.ResolvedEndpoint{}, fmt.Errorf("no FIPS endpoint found for service (%s) in region (%s), configure one in the provider endpoints configuration block", service, region)
+}
+
+// dualStackEndpointFor returns the dual-stack endpoint of the service.
+// The SDK models no dual-stack variants of FIPS endpoints.
+func (r *Resolver) dualStackEndpointFor(partition endpoints.Partition, service, region string, resolved endpoints.ResolvedEndpoint, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	if strings.Contains(resolved.URL, ".dualstack.") {
+		return resolved, nil
+	}
+
+	if r.useFIPS {
+		return endpoints.ResolvedEndpoint{}, fmt.Errorf("no FIPS dual-stack endpoint found for service (%s) in region (%s), configure one in the provider endpoints configuration block", service, region)
+	}
+
+	dualStack, err := partition.EndpointFor(service, region, append(opts, endpoints.UseDualStackOption)...)
+
+	if err != nil || dualStack.URL == resolved.URL {
+		return endpoints.ResolvedEndpoint{}, fmt.Errorf("no dual-stack endpoint found for service (%s) in region (%s), configure one in the provider endpoints configuration block", service, region)
+	}
+
+	return dualStack, nil
+}
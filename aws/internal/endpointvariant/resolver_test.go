@@ -0,0 +1,113 @@
+package endpointvariant
+
+import (
+	"testing"
+)
+
+func TestResolverEndpointFor(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		UseFIPS               bool
+		UseDualStack          bool
+		Service               string
+		Region                string
+		ExpectedURL           string
+		ExpectedSigningRegion string
+		ExpectedError         bool
+	}{
+		{
+			Name:                  "no variant",
+			Service:               "sqs",
+			Region:                "us-west-2",
+			ExpectedURL:           "https://sqs.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: "us-west-2",
+		},
+		{
+			Name:                  "FIPS region prefix",
+			UseFIPS:               true,
+			Service:               "sqs",
+			Region:                "us-west-2",
+			ExpectedURL:           "https://sqs-fips.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: "us-west-2",
+		},
+		{
+			Name:                  "FIPS region suffix",
+			UseFIPS:               true,
+			Service:               "sts",
+			Region:                "us-east-1",
+			ExpectedURL:           "https://sts-fips.us-east-1.amazonaws.com",
+			ExpectedSigningRegion: "us-east-1",
+		},
+		{
+			Name:                  "FIPS global service",
+			UseFIPS:               true,
+			Service:               "route53",
+			Region:                "us-west-2",
+			ExpectedURL:           "https://route53-fips.amazonaws.com",
+			ExpectedSigningRegion: "us-east-1",
+		},
+		{
+			Name:          "FIPS not available",
+			UseFIPS:       true,
+			Service:       "sqs",
+			Region:        "eu-west-1",
+			ExpectedError: true,
+		},
+		{
+			Name:                  "dual-stack",
+			UseDualStack:          true,
+			Service:               "s3",
+			Region:                "us-west-2",
+			ExpectedURL:           "https://s3.dualstack.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: "us-west-2",
+		},
+		{
+			Name:          "dual-stack not available",
+			UseDualStack:  true,
+			Service:       "sqs",
+			Region:        "us-west-2",
+			ExpectedError: true,
+		},
+		{
+			Name:          "FIPS and dual-stack",
+			UseFIPS:       true,
+			UseDualStack:  true,
+			Service:       "sqs",
+			Region:        "us-west-2",
+			ExpectedError: true,
+		},
+		{
+			Name:          "unknown region",
+			UseFIPS:       true,
+			Service:       "sqs",
+			Region:        "unknown",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := NewResolver(testCase.UseFIPS, testCase.UseDualStack).EndpointFor(testCase.Service, testCase.Region)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got.URL)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.URL != testCase.ExpectedURL {
+				t.Errorf("got URL %s, expected %s", got.URL, testCase.ExpectedURL)
+			}
+
+			if got.SigningRegion != testCase.ExpectedSigningRegion {
+				t.Errorf("got signing region %s, expected %s", got.SigningRegion, testCase.ExpectedSigningRegion)
+			}
+		})
+	}
+}
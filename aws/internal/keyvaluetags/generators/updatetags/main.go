@@ -45,6 +45,7 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"connect",
 	"databasemigrationservice",
 	"dataexchange",
 	"datapipeline",
@@ -88,12 +89,14 @@ var serviceNames = []string{
 	"lambda",
 	"licensemanager",
 	"lightsail",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
 	"mediapackage",
 	"mediastore",
 	"mq",
+	"mwaa",
 	"neptune",
 	"networkfirewall",
 	"networkmanager",
@@ -107,6 +110,7 @@ var serviceNames = []string{
 	"redshift",
 	"resourcegroups",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"sagemaker",
 	"secretsmanager",
@@ -121,6 +125,7 @@ var serviceNames = []string{
 	"storagegateway",
 	"swf",
 	"synthetics",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
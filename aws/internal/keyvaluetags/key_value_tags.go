@@ -25,12 +25,102 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+const (
+	RequiredEnforcementError = `error`
+	RequiredEnforcementWarn  = `warn`
+)
+
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
 }
 
+// RequiredConfig contains various options for enforcing resource tags.
+type RequiredConfig struct {
+	Enforcement         string
+	ExemptResourceTypes map[string]struct{}
+	Tags                []*RequiredTag
+}
+
+// RequiredTag contains the constraints for a single required resource tag key.
+type RequiredTag struct {
+	Key           string
+	AllowedValues []string
+	ValueRegex    *regexp.Regexp
+}
+
+// MergeTags returns the result of merging the given tags onto the
+// configuration default tags, with the given tags overriding the value
+// of any default tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
+// IsExempt returns true if the given resource type is exempt from the
+// configuration required tags; otherwise returns false.
+func (rc *RequiredConfig) IsExempt(resourceType string) bool {
+	if rc == nil {
+		return true
+	}
+
+	_, ok := rc.ExemptResourceTypes[resourceType]
+
+	return ok
+}
+
+// Violations returns a description of each configuration required tag
+// missing from, or with a disallowed value in, the given tags.
+func (rc *RequiredConfig) Violations(tags KeyValueTags) []string {
+	if rc == nil {
+		return nil
+	}
+
+	var result []string
+
+	for _, rt := range rc.Tags {
+		present := tags.Only(New([]string{rt.Key}))
+
+		if len(present) == 0 {
+			result = append(result, fmt.Sprintf("missing required tag %q", rt.Key))
+			continue
+		}
+
+		if len(rt.AllowedValues) > 0 {
+			allowed := false
+
+			for _, v := range rt.AllowedValues {
+				if present.ContainsAll(New(map[string]string{rt.Key: v})) {
+					allowed = true
+					break
+				}
+			}
+
+			if !allowed {
+				result = append(result, fmt.Sprintf("tag %q value %q is not one of the allowed values (%s)", rt.Key, present.Map()[rt.Key], strings.Join(rt.AllowedValues, ", ")))
+				continue
+			}
+		}
+
+		if rt.ValueRegex != nil {
+			if v := present.Map()[rt.Key]; !rt.ValueRegex.MatchString(v) {
+				result = append(result, fmt.Sprintf("tag %q value %q does not match %q", rt.Key, v, rt.ValueRegex.String()))
+			}
+		}
+	}
+
+	return result
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -147,6 +237,24 @@ func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags not present in a DefaultConfig, in addition
+// to tags whose values override those in a DefaultConfig.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -1,6 +1,7 @@
 package keyvaluetags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -60,6 +61,319 @@ func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys some overridden",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MergeTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "keys all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "keys some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys overridden",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigIsExempt(t *testing.T) {
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		resourceType   string
+		want           bool
+	}{
+		{
+			name:           "nil config",
+			requiredConfig: nil,
+			resourceType:   "aws_vpc",
+			want:           true,
+		},
+		{
+			name: "not exempt",
+			requiredConfig: &RequiredConfig{
+				ExemptResourceTypes: map[string]struct{}{"aws_subnet": {}},
+			},
+			resourceType: "aws_vpc",
+			want:         false,
+		},
+		{
+			name: "exempt",
+			requiredConfig: &RequiredConfig{
+				ExemptResourceTypes: map[string]struct{}{"aws_vpc": {}},
+			},
+			resourceType: "aws_vpc",
+			want:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.IsExempt(testCase.resourceType)
+
+			if got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigViolations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		tags           KeyValueTags
+		want           int
+	}{
+		{
+			name:           "nil config",
+			requiredConfig: nil,
+			tags:           New(map[string]string{}),
+			want:           0,
+		},
+		{
+			name: "missing key",
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{{Key: "key1"}},
+			},
+			tags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: 1,
+		},
+		{
+			name: "present key",
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{{Key: "key1"}},
+			},
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: 0,
+		},
+		{
+			name: "allowed value",
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{{Key: "key1", AllowedValues: []string{"value1", "value2"}}},
+			},
+			tags: New(map[string]string{
+				"key1": "value2",
+			}),
+			want: 0,
+		},
+		{
+			name: "disallowed value",
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{{Key: "key1", AllowedValues: []string{"value1", "value2"}}},
+			},
+			tags: New(map[string]string{
+				"key1": "value3",
+			}),
+			want: 1,
+		},
+		{
+			name: "matching value regex",
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{{Key: "key1", ValueRegex: regexp.MustCompile(`^cc-[0-9]+$`)}},
+			},
+			tags: New(map[string]string{
+				"key1": "cc-1234",
+			}),
+			want: 0,
+		},
+		{
+			name: "non-matching value regex",
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{{Key: "key1", ValueRegex: regexp.MustCompile(`^cc-[0-9]+$`)}},
+			},
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: 1,
+		},
+		{
+			name: "multiple violations",
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "key1", AllowedValues: []string{"value1"}},
+					{Key: "key2"},
+					{Key: "key3"},
+				},
+			},
+			tags: New(map[string]string{
+				"key1": "value2",
+				"key3": "value3",
+			}),
+			want: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.Violations(testCase.tags)
+
+			if len(got) != testCase.want {
+				t.Errorf("got %d violations (%v); want %d", len(got), got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	testCases := []struct {
 		name         string
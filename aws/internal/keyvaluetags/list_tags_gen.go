@@ -31,6 +31,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datasync"
@@ -72,12 +73,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/aws/aws-sdk-go/service/mediastore"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
@@ -89,6 +92,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/securityhub"
@@ -101,6 +105,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
@@ -574,6 +579,23 @@ func ConfigserviceListTags(conn *configservice.ConfigService, identifier string)
 	return ConfigserviceKeyValueTags(output.Tags), nil
 }
 
+// ConnectListTags lists connect service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ConnectListTags(conn *connect.Connect, identifier string) (KeyValueTags, error) {
+	input := &connect.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return ConnectKeyValueTags(output.Tags), nil
+}
+
 // DatabasemigrationserviceListTags lists databasemigrationservice service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1276,6 +1298,23 @@ func LicensemanagerListTags(conn *licensemanager.LicenseManager, identifier stri
 	return LicensemanagerKeyValueTags(output.Tags), nil
 }
 
+// Macie2ListTags lists macie2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Macie2ListTags(conn *macie2.Macie2, identifier string) (KeyValueTags, error) {
+	input := &macie2.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return Macie2KeyValueTags(output.Tags), nil
+}
+
 // MediaconnectListTags lists mediaconnect service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1378,6 +1417,23 @@ func MqListTags(conn *mq.MQ, identifier string) (KeyValueTags, error) {
 	return MqKeyValueTags(output.Tags), nil
 }
 
+// MwaaListTags lists mwaa service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MwaaListTags(conn *mwaa.MWAA, identifier string) (KeyValueTags, error) {
+	input := &mwaa.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return MwaaKeyValueTags(output.Tags), nil
+}
+
 // NeptuneListTags lists neptune service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1566,6 +1622,23 @@ func Route53ListTags(conn *route53.Route53, identifier string, resourceType stri
 	return Route53KeyValueTags(output.ResourceTagSet.Tags), nil
 }
 
+// Route53domainsListTags lists route53domains service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Route53domainsListTags(conn *route53domains.Route53Domains, identifier string) (KeyValueTags, error) {
+	input := &route53domains.ListTagsForDomainInput{
+		DomainName: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForDomain(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return Route53domainsKeyValueTags(output.TagList), nil
+}
+
 // Route53resolverListTags lists route53resolver service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1772,6 +1845,23 @@ func SwfListTags(conn *swf.SWF, identifier string) (KeyValueTags, error) {
 	return SwfKeyValueTags(output.Tags), nil
 }
 
+// TimestreamwriteListTags lists timestreamwrite service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func TimestreamwriteListTags(conn *timestreamwrite.TimestreamWrite, identifier string) (KeyValueTags, error) {
+	input := &timestreamwrite.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return TimestreamwriteKeyValueTags(output.Tags), nil
+}
+
 // TransferListTags lists transfer service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -34,6 +34,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
@@ -78,12 +79,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/aws/aws-sdk-go/service/mediastore"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
@@ -98,6 +101,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -112,6 +116,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
@@ -184,6 +189,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(cognitoidentityprovider.New)
 	case "configservice":
 		funcType = reflect.TypeOf(configservice.New)
+	case "connect":
+		funcType = reflect.TypeOf(connect.New)
 	case "databasemigrationservice":
 		funcType = reflect.TypeOf(databasemigrationservice.New)
 	case "dataexchange":
@@ -272,6 +279,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(licensemanager.New)
 	case "lightsail":
 		funcType = reflect.TypeOf(lightsail.New)
+	case "macie2":
+		funcType = reflect.TypeOf(macie2.New)
 	case "mediaconnect":
 		funcType = reflect.TypeOf(mediaconnect.New)
 	case "mediaconvert":
@@ -284,6 +293,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(mediastore.New)
 	case "mq":
 		funcType = reflect.TypeOf(mq.New)
+	case "mwaa":
+		funcType = reflect.TypeOf(mwaa.New)
 	case "neptune":
 		funcType = reflect.TypeOf(neptune.New)
 	case "networkfirewall":
@@ -312,6 +323,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(resourcegroupstaggingapi.New)
 	case "route53":
 		funcType = reflect.TypeOf(route53.New)
+	case "route53domains":
+		funcType = reflect.TypeOf(route53domains.New)
 	case "route53resolver":
 		funcType = reflect.TypeOf(route53resolver.New)
 	case "sagemaker":
@@ -340,6 +353,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(swf.New)
 	case "synthetics":
 		funcType = reflect.TypeOf(synthetics.New)
+	case "timestreamwrite":
+		funcType = reflect.TypeOf(timestreamwrite.New)
 	case "transfer":
 		funcType = reflect.TypeOf(transfer.New)
 	case "waf":
@@ -418,6 +433,8 @@ func ServiceListTagsFunction(serviceName string) string {
 		return "DescribeTags"
 	case "resourcegroups":
 		return "GetTags"
+	case "route53domains":
+		return "ListTagsForDomain"
 	case "sagemaker":
 		return "ListTags"
 	case "sqs":
@@ -511,6 +528,8 @@ func ServiceListTagsOutputTagsField(serviceName string) string {
 		return "TagList"
 	case "route53":
 		return "ResourceTagSet.Tags"
+	case "route53domains":
+		return "TagList"
 	case "ssm":
 		return "TagList"
 	case "waf":
@@ -613,6 +632,8 @@ func ServiceTagFunction(serviceName string) string {
 		return "Tag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "UpdateTagsForDomain"
 	case "sagemaker":
 		return "AddTags"
 	case "sqs":
@@ -727,6 +748,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "Arn"
 	case "route53":
 		return "ResourceId"
+	case "route53domains":
+		return "DomainName"
 	case "secretsmanager":
 		return "SecretId"
 	case "servicediscovery":
@@ -737,6 +760,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "ResourceId"
 	case "storagegateway":
 		return "ResourceARN"
+	case "timestreamwrite":
+		return "ResourceARN"
 	case "transfer":
 		return "Arn"
 	case "waf":
@@ -785,6 +810,8 @@ func ServiceTagInputTagsField(serviceName string) string {
 		return "TagsModel"
 	case "route53":
 		return "AddTags"
+	case "route53domains":
+		return "TagsToUpdate"
 	default:
 		return "Tags"
 	}
@@ -969,6 +996,8 @@ func ServiceUntagFunction(serviceName string) string {
 		return "Untag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "DeleteTagsForDomain"
 	case "sagemaker":
 		return "DeleteTags"
 	case "sqs":
@@ -1045,6 +1074,8 @@ func ServiceUntagInputTagsField(serviceName string) string {
 		return "Keys"
 	case "route53":
 		return "RemoveTagKeys"
+	case "route53domains":
+		return "TagsToDelete"
 	default:
 		return "TagKeys"
 	}
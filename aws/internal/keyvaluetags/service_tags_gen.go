@@ -69,6 +69,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3control"
@@ -83,6 +84,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafv2"
@@ -223,6 +225,16 @@ func CognitoidentityproviderKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// ConnectTags returns connect service tags.
+func (tags KeyValueTags) ConnectTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// ConnectKeyValueTags creates KeyValueTags from connect service tags.
+func ConnectKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // DataexchangeTags returns dataexchange service tags.
 func (tags KeyValueTags) DataexchangeTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -333,6 +345,16 @@ func LambdaKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// Macie2Tags returns macie2 service tags.
+func (tags KeyValueTags) Macie2Tags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// Macie2KeyValueTags creates KeyValueTags from macie2 service tags.
+func Macie2KeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // MediaconnectTags returns mediaconnect service tags.
 func (tags KeyValueTags) MediaconnectTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -383,6 +405,16 @@ func MqKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// MwaaTags returns mwaa service tags.
+func (tags KeyValueTags) MwaaTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// MwaaKeyValueTags creates KeyValueTags from mwaa service tags.
+func MwaaKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // OpsworksTags returns opsworks service tags.
 func (tags KeyValueTags) OpsworksTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -2336,6 +2368,33 @@ func Route53KeyValueTags(tags []*route53.Tag) KeyValueTags {
 	return New(m)
 }
 
+// Route53domainsTags returns route53domains service tags.
+func (tags KeyValueTags) Route53domainsTags() []*route53domains.Tag {
+	result := make([]*route53domains.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &route53domains.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Route53domainsKeyValueTags creates KeyValueTags from route53domains service tags.
+func Route53domainsKeyValueTags(tags []*route53domains.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // Route53resolverTags returns route53resolver service tags.
 func (tags KeyValueTags) Route53resolverTags() []*route53resolver.Tag {
 	result := make([]*route53resolver.Tag, 0, len(tags))
@@ -2714,6 +2773,33 @@ func SwfKeyValueTags(tags []*swf.ResourceTag) KeyValueTags {
 	return New(m)
 }
 
+// TimestreamwriteTags returns timestreamwrite service tags.
+func (tags KeyValueTags) TimestreamwriteTags() []*timestreamwrite.Tag {
+	result := make([]*timestreamwrite.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &timestreamwrite.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// TimestreamwriteKeyValueTags creates KeyValueTags from timestreamwrite service tags.
+func TimestreamwriteKeyValueTags(tags []*timestreamwrite.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // TransferTags returns transfer service tags.
 func (tags KeyValueTags) TransferTags() []*transfer.Tag {
 	result := make([]*transfer.Tag, 0, len(tags))
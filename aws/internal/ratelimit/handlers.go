@@ -0,0 +1,230 @@
+package ratelimit
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Config contains the per-service client-side rate limits.
+type Config struct {
+	Adaptive bool
+	// AdaptiveAll enables adaptive rate limiting of the services without a
+	// configured rate, starting from the rate measured when the service
+	// first throttles a request.
+	AdaptiveAll bool
+	Services    map[string]*ServiceConfig
+}
+
+// ServiceConfig contains the client-side rate limit for a single service.
+type ServiceConfig struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+var throttles = struct {
+	sync.Mutex
+	counts map[string]int
+}{
+	counts: make(map[string]int),
+}
+
+// ServiceKey returns the key used to identify the service a request is sent to,
+// the SDK service ID in lower case without spaces (e.g. "ec2" or "route53").
+func ServiceKey(r *request.Request) string {
+	return strings.ToLower(strings.ReplaceAll(r.ClientInfo.ServiceID, " ", ""))
+}
+
+// InstallHandlers adds the rate limiting and throttle counting handlers
+// to the given handler list. Clients created from a session copy its handlers,
+// so this must be called before any service clients are created.
+// Throttles are counted even if config is nil.
+func InstallHandlers(handlers *request.Handlers, config *Config) {
+	limiters := newLimiterSet(config)
+
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ratelimit.Wait",
+		Fn: func(r *request.Request) {
+			limiter, ok := limiters.sent(ServiceKey(r))
+
+			if !ok {
+				return
+			}
+
+			if err := limiter.Wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while rate limited", err)
+			}
+		},
+	})
+
+	handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ratelimit.Throttled",
+		Fn: func(r *request.Request) {
+			if !request.IsErrorThrottle(r.Error) {
+				return
+			}
+
+			service := ServiceKey(r)
+
+			log.Printf("[DEBUG] %s/%s throttled (retry count %d)", service, r.Operation.Name, r.RetryCount)
+			recordThrottle(service)
+
+			if limiter, ok := limiters.throttled(service); ok {
+				limiter.Throttled()
+			}
+		},
+	})
+
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ratelimit.Succeeded",
+		Fn: func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			if limiter, ok := limiters.get(ServiceKey(r)); ok {
+				limiter.Succeeded()
+			}
+		},
+	})
+}
+
+// ThrottleCounts returns the number of throttled requests per service.
+func ThrottleCounts() map[string]int {
+	throttles.Lock()
+	defer throttles.Unlock()
+
+	result := make(map[string]int, len(throttles.counts))
+
+	for k, v := range throttles.counts {
+		result[k] = v
+	}
+
+	return result
+}
+
+// LogThrottleSummary logs the number of throttled requests per service.
+func LogThrottleSummary() {
+	counts := ThrottleCounts()
+
+	if len(counts) == 0 {
+		return
+	}
+
+	services := make([]string, 0, len(counts))
+
+	for service := range counts {
+		services = append(services, service)
+	}
+
+	sort.Strings(services)
+
+	summary := make([]string, 0, len(services))
+
+	for _, service := range services {
+		summary = append(summary, fmt.Sprintf("%s=%d", service, counts[service]))
+	}
+
+	log.Printf("[WARN] AWS API requests throttled: %s", strings.Join(summary, ", "))
+}
+
+// limiterSet holds the limiters of each service. With AdaptiveAll, the
+// request rate of services without a limiter is measured and a limiter is
+// created when the service first throttles a request.
+type limiterSet struct {
+	adaptiveAll bool
+
+	mu       sync.Mutex
+	limiters map[string]*Limiter
+	meters   map[string]*Meter
+}
+
+func newLimiterSet(config *Config) *limiterSet {
+	s := &limiterSet{
+		limiters: make(map[string]*Limiter),
+		meters:   make(map[string]*Meter),
+	}
+
+	if config != nil {
+		s.adaptiveAll = config.AdaptiveAll
+
+		for service, serviceConfig := range config.Services {
+			s.limiters[service] = NewLimiter(serviceConfig.RequestsPerSecond, serviceConfig.Burst, config.Adaptive)
+		}
+	}
+
+	return s
+}
+
+// get returns the limiter of the service.
+func (s *limiterSet) get(service string) (*Limiter, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limiter, ok := s.limiters[service]
+
+	return limiter, ok
+}
+
+// sent records a request to the service and returns its limiter.
+func (s *limiterSet) sent(service string) (*Limiter, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if limiter, ok := s.limiters[service]; ok {
+		return limiter, true
+	}
+
+	if s.adaptiveAll {
+		meter, ok := s.meters[service]
+
+		if !ok {
+			meter = NewMeter()
+			s.meters[service] = meter
+		}
+
+		meter.Add()
+	}
+
+	return nil, false
+}
+
+// throttled returns the limiter of the service, creating it from the
+// measured request rate if needed.
+func (s *limiterSet) throttled(service string) (*Limiter, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if limiter, ok := s.limiters[service]; ok {
+		return limiter, true
+	}
+
+	meter, ok := s.meters[service]
+
+	if !s.adaptiveAll || !ok {
+		return nil, false
+	}
+
+	rate := math.Max(meter.Rate(), 1)
+
+	log.Printf("[DEBUG] Adaptive rate limiting %s from %.1f requests per second", service, rate)
+
+	limiter := NewLimiter(rate, 1, true)
+	s.limiters[service] = limiter
+	delete(s.meters, service)
+
+	return limiter, true
+}
+
+func recordThrottle(service string) {
+	throttles.Lock()
+	defer throttles.Unlock()
+
+	throttles.counts[service]++
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"testing"
+)
+
+func TestLimiterSetAdaptiveAll(t *testing.T) {
+	limiters := newLimiterSet(&Config{
+		AdaptiveAll: true,
+		Services: map[string]*ServiceConfig{
+			"ec2": {Burst: 1, RequestsPerSecond: 5},
+		},
+	})
+
+	if _, ok := limiters.sent("ec2"); !ok {
+		t.Error("configured service: expected limiter")
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, ok := limiters.sent("iam"); ok {
+			t.Fatal("unconfigured service before throttle: expected no limiter")
+		}
+	}
+
+	limiter, ok := limiters.throttled("iam")
+
+	if !ok {
+		t.Fatal("unconfigured service after throttle: expected limiter")
+	}
+
+	// 10 requests within the first measured interval
+	if got := limiter.Rate(); got <= 1 {
+		t.Errorf("got rate %f; want measured rate", got)
+	}
+
+	if got, ok := limiters.get("iam"); !ok || got != limiter {
+		t.Error("expected limiter to be reused")
+	}
+}
+
+func TestLimiterSetNotAdaptiveAll(t *testing.T) {
+	limiters := newLimiterSet(&Config{})
+
+	limiters.sent("iam")
+
+	if _, ok := limiters.throttled("iam"); ok {
+		t.Error("expected no limiter")
+	}
+
+	limiters = newLimiterSet(nil)
+
+	if _, ok := limiters.throttled("iam"); ok {
+		t.Error("nil config: expected no limiter")
+	}
+}
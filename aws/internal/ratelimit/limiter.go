@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// adaptiveDecreaseFactor is the factor the fill rate is multiplied by
+	// each time the service throttles a request.
+	adaptiveDecreaseFactor = 0.5
+
+	// adaptiveIncreaseFactor is the fraction of the configured fill rate
+	// the fill rate recovers by for each successful request.
+	adaptiveIncreaseFactor = 0.05
+
+	// adaptiveMinimumFactor is the fraction of the configured fill rate
+	// below which the fill rate is never reduced.
+	adaptiveMinimumFactor = 0.1
+)
+
+// Limiter is a token bucket limiting the rate of requests to a single service.
+// When adaptive, the fill rate is reduced each time the service throttles a
+// request and recovers towards the configured rate as requests succeed.
+type Limiter struct {
+	adaptive bool
+	burst    float64
+	maxRate  float64
+	minRate  float64
+
+	mu     sync.Mutex
+	last   time.Time
+	rate   float64
+	tokens float64
+
+	now func() time.Time
+}
+
+// NewLimiter returns a Limiter allowing requestsPerSecond requests per second
+// on average with bursts of up to burst requests.
+func NewLimiter(requestsPerSecond float64, burst int, adaptive bool) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Limiter{
+		adaptive: adaptive,
+		burst:    float64(burst),
+		maxRate:  requestsPerSecond,
+		minRate:  requestsPerSecond * adaptiveMinimumFactor,
+		rate:     requestsPerSecond,
+		tokens:   float64(burst),
+		now:      time.Now,
+	}
+}
+
+// Rate returns the current fill rate in requests per second.
+func (l *Limiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// Wait blocks until a request may be sent or the context is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+
+		return ctx.Err()
+	}
+}
+
+// Throttled records that the service throttled a request.
+func (l *Limiter) Throttled() {
+	if !l.adaptive {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	l.rate = math.Max(l.minRate, l.rate*adaptiveDecreaseFactor)
+}
+
+// Succeeded records that the service accepted a request.
+func (l *Limiter) Succeeded() {
+	if !l.adaptive {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	l.rate = math.Min(l.maxRate, l.rate+l.maxRate*adaptiveIncreaseFactor)
+}
+
+// reserve takes a token from the bucket and returns how long the caller must
+// wait before the token is available.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// refill adds the tokens accrued since the last refill.
+// The caller must hold the lock.
+func (l *Limiter) refill() {
+	now := l.now()
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+
+	l.last = now
+}
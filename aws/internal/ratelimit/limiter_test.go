@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(requestsPerSecond float64, burst int, adaptive bool) (*Limiter, *testClock) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	limiter := NewLimiter(requestsPerSecond, burst, adaptive)
+	limiter.now = clock.Now
+
+	return limiter, clock
+}
+
+func TestLimiterReserve(t *testing.T) {
+	limiter, clock := newTestLimiter(10, 2, false)
+
+	if got := limiter.reserve(); got != 0 {
+		t.Errorf("first request: got delay %s; want 0", got)
+	}
+
+	if got := limiter.reserve(); got != 0 {
+		t.Errorf("second request (burst): got delay %s; want 0", got)
+	}
+
+	if got, want := limiter.reserve(), 100*time.Millisecond; got != want {
+		t.Errorf("third request: got delay %s; want %s", got, want)
+	}
+
+	clock.Advance(1 * time.Second)
+
+	if got := limiter.reserve(); got != 0 {
+		t.Errorf("after refill: got delay %s; want 0", got)
+	}
+}
+
+func TestLimiterAdaptive(t *testing.T) {
+	limiter, _ := newTestLimiter(10, 1, true)
+
+	limiter.Throttled()
+
+	if got, want := limiter.Rate(), 5.0; got != want {
+		t.Errorf("after throttle: got rate %f; want %f", got, want)
+	}
+
+	for i := 0; i < 10; i++ {
+		limiter.Throttled()
+	}
+
+	if got, want := limiter.Rate(), 1.0; got != want {
+		t.Errorf("after repeated throttles: got rate %f; want %f", got, want)
+	}
+
+	limiter.Succeeded()
+
+	if got, want := limiter.Rate(), 1.5; got != want {
+		t.Errorf("after success: got rate %f; want %f", got, want)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.Succeeded()
+	}
+
+	if got, want := limiter.Rate(), 10.0; got != want {
+		t.Errorf("after repeated successes: got rate %f; want %f", got, want)
+	}
+}
+
+func TestLimiterNotAdaptive(t *testing.T) {
+	limiter, _ := newTestLimiter(10, 1, false)
+
+	limiter.Throttled()
+
+	if got, want := limiter.Rate(), 10.0; got != want {
+		t.Errorf("after throttle: got rate %f; want %f", got, want)
+	}
+}
+
+func TestLimiterWaitCanceled(t *testing.T) {
+	limiter, _ := newTestLimiter(0.001, 1, false)
+
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("first request: unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := limiter.Wait(ctx); err == nil {
+		t.Error("expected error for canceled context")
+	}
+
+	if got, want := limiter.tokens, 0.0; got != want {
+		t.Errorf("got %f tokens after cancel; want %f", got, want)
+	}
+}
@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// meterInterval is the length of the intervals requests are counted over.
+	meterInterval = 500 * time.Millisecond
+
+	// meterSmoothing is the weight of the last interval in the measured rate.
+	meterSmoothing = 0.8
+
+	// meterMaximumIdleIntervals is the number of intervals without requests
+	// after which the measured rate is reset.
+	meterMaximumIdleIntervals = 10
+)
+
+// Meter measures the rate of requests sent to a single service, smoothed
+// over consecutive intervals.
+type Meter struct {
+	mu    sync.Mutex
+	count int
+	rate  float64
+	start time.Time
+
+	now func() time.Time
+}
+
+// NewMeter returns a new Meter.
+func NewMeter() *Meter {
+	return &Meter{
+		now: time.Now,
+	}
+}
+
+// Add records a request.
+func (m *Meter) Add() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.advance()
+	m.count++
+}
+
+// Rate returns the measured rate in requests per second.
+func (m *Meter) Rate() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.advance()
+
+	return math.Max(m.rate, float64(m.count)/meterInterval.Seconds())
+}
+
+// advance folds the counts of elapsed intervals into the measured rate.
+// The caller must hold the lock.
+func (m *Meter) advance() {
+	now := m.now()
+
+	if m.start.IsZero() {
+		m.start = now
+
+		return
+	}
+
+	if now.Sub(m.start) >= meterMaximumIdleIntervals*meterInterval {
+		m.count = 0
+		m.rate = 0
+		m.start = now
+
+		return
+	}
+
+	for now.Sub(m.start) >= meterInterval {
+		m.rate = meterSmoothing*float64(m.count)/meterInterval.Seconds() + (1-meterSmoothing)*m.rate
+		m.count = 0
+		m.start = m.start.Add(meterInterval)
+	}
+}
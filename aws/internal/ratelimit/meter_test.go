@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestMeter() (*Meter, *testClock) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	meter := NewMeter()
+	meter.now = clock.Now
+
+	return meter, clock
+}
+
+func TestMeterRate(t *testing.T) {
+	meter, clock := newTestMeter()
+
+	if got := meter.Rate(); got != 0 {
+		t.Errorf("no requests: got rate %f; want 0", got)
+	}
+
+	// 5 requests per interval, i.e. 10 requests per second
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 5; j++ {
+			meter.Add()
+		}
+
+		clock.Advance(meterInterval)
+	}
+
+	if got := meter.Rate(); got < 9.9 || got > 10 {
+		t.Errorf("steady requests: got rate %f; want 10", got)
+	}
+
+	clock.Advance(meterMaximumIdleIntervals * meterInterval)
+
+	if got := meter.Rate(); got != 0 {
+		t.Errorf("after idle: got rate %f; want 0", got)
+	}
+}
+
+func TestMeterRatePartialInterval(t *testing.T) {
+	meter, _ := newTestMeter()
+
+	for i := 0; i < 3; i++ {
+		meter.Add()
+	}
+
+	if got, want := meter.Rate(), 6.0; got != want {
+		t.Errorf("got rate %f; want %f", got, want)
+	}
+}
@@ -0,0 +1,187 @@
+package readcache
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// parentFieldNames are the input fields identifying the parent resource
+// of a mutating API call, e.g. the security group of an ingress rule.
+var parentFieldNames = []string{
+	"GroupId",
+	"RouteTableId",
+	"RoleName",
+}
+
+// Cache is a read-through cache of API responses for the duration of a run.
+// Each entry is associated with a service and a parent resource identifier and
+// is invalidated by any mutating call to that service and parent.
+// A nil Cache is valid and caches nothing.
+type Cache struct {
+	mu         sync.Mutex
+	entries    map[string]*entry
+	generation uint64
+}
+
+type entry struct {
+	done    chan struct{}
+	err     error
+	output  interface{}
+	parent  string
+	service string
+}
+
+// New returns an empty Cache.
+func New() *Cache {
+	return &Cache{
+		entries: make(map[string]*entry),
+	}
+}
+
+// Get returns the cached output of the service operation for the given input,
+// calling fetch to populate the cache on a miss. Concurrent misses for the same
+// key share a single call to fetch. Errors are never cached.
+// Callers must not modify the returned output.
+func (c *Cache) Get(service, operation, parent string, input interface{}, fetch func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return fetch()
+	}
+
+	key := strings.Join([]string{service, operation, string(b)}, "/")
+
+	c.mu.Lock()
+
+	if e, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		<-e.done
+
+		return e.output, e.err
+	}
+
+	e := &entry{
+		done:    make(chan struct{}),
+		parent:  parent,
+		service: service,
+	}
+	c.entries[key] = e
+	generation := c.generation
+
+	c.mu.Unlock()
+
+	e.output, e.err = fetch()
+	close(e.done)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Drop errors and any response which may have been fetched before
+	// a concurrent mutation.
+	if e.err != nil || c.generation != generation {
+		if c.entries[key] == e {
+			delete(c.entries, key)
+		}
+	}
+
+	return e.output, e.err
+}
+
+// Invalidate removes all entries for the service and parent.
+// An empty parent removes all entries for the service.
+func (c *Cache) Invalidate(service, parent string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	for key, e := range c.entries {
+		if e.service != service {
+			continue
+		}
+
+		if parent == "" || e.parent == parent {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// InstallHandlers adds the invalidation handler to the given handler list.
+// Clients created from a session copy its handlers, so this must be called
+// before any service clients are created.
+func (c *Cache) InstallHandlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.readcache.Invalidate",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || !IsMutating(r.Operation.Name) {
+				return
+			}
+
+			c.Invalidate(r.ClientInfo.ServiceID, ParentID(r.Params))
+		},
+	})
+}
+
+// IsMutating returns whether the named API operation may modify resources.
+func IsMutating(operation string) bool {
+	for _, prefix := range []string{"Describe", "Get", "List"} {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParentID returns the parent resource identifier from an API input,
+// or an empty string if it cannot be determined.
+func ParentID(input interface{}) string {
+	v := reflect.ValueOf(input)
+
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for _, name := range parentFieldNames {
+		field := v.FieldByName(name)
+
+		if !field.IsValid() {
+			continue
+		}
+
+		if s, ok := field.Interface().(*string); ok && s != nil {
+			return aws.StringValue(s)
+		}
+	}
+
+	return ""
+}
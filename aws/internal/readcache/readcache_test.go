@@ -0,0 +1,220 @@
+package readcache
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+func TestCacheGet(t *testing.T) {
+	cache := New()
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+	input := &ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{"sg-1"})}
+
+	for i := 0; i < 3; i++ {
+		got, err := cache.Get(ec2.ServiceID, "DescribeSecurityGroups", "sg-1", input, fetch)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != 1 {
+			t.Errorf("got %v; want cached 1", got)
+		}
+	}
+
+	other := &ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{"sg-2"})}
+
+	if got, _ := cache.Get(ec2.ServiceID, "DescribeSecurityGroups", "sg-2", other, fetch); got != 2 {
+		t.Errorf("got %v for different input; want 2", got)
+	}
+}
+
+func TestCacheGetError(t *testing.T) {
+	cache := New()
+	input := &iam.ListAttachedRolePoliciesInput{RoleName: aws.String("role")}
+
+	_, err := cache.Get(iam.ServiceID, "ListAttachedRolePolicies", "role", input, func() (interface{}, error) {
+		return nil, errors.New("test")
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := cache.Len(); got != 0 {
+		t.Errorf("got %d entries after error; want 0", got)
+	}
+}
+
+func TestCacheGetConcurrent(t *testing.T) {
+	cache := New()
+	input := &ec2.DescribeRouteTablesInput{RouteTableIds: aws.StringSlice([]string{"rtb-1"})}
+	release := make(chan struct{})
+	var mu sync.Mutex
+	calls := 0
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			cache.Get(ec2.ServiceID, "DescribeRouteTables", "rtb-1", input, func() (interface{}, error) {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+				<-release
+				return "rtb-1", nil
+			})
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+
+	if calls < 1 || calls > 10 {
+		t.Fatalf("got %d calls", calls)
+	}
+
+	if got := cache.Len(); got != 1 {
+		t.Errorf("got %d entries; want 1", got)
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		service string
+		parent  string
+		want    int
+	}{
+		{
+			name:    "other service",
+			service: iam.ServiceID,
+			parent:  "sg-1",
+			want:    2,
+		},
+		{
+			name:    "other parent",
+			service: ec2.ServiceID,
+			parent:  "sg-3",
+			want:    2,
+		},
+		{
+			name:    "matching parent",
+			service: ec2.ServiceID,
+			parent:  "sg-1",
+			want:    1,
+		},
+		{
+			name:    "unknown parent",
+			service: ec2.ServiceID,
+			parent:  "",
+			want:    0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cache := New()
+
+			for _, id := range []string{"sg-1", "sg-2"} {
+				input := &ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{id})}
+				cache.Get(ec2.ServiceID, "DescribeSecurityGroups", id, input, func() (interface{}, error) {
+					return id, nil
+				})
+			}
+
+			cache.Invalidate(testCase.service, testCase.parent)
+
+			if got := cache.Len(); got != testCase.want {
+				t.Errorf("got %d entries; want %d", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestCacheNil(t *testing.T) {
+	var cache *Cache
+	calls := 0
+
+	for i := 0; i < 2; i++ {
+		cache.Get(ec2.ServiceID, "DescribeSecurityGroups", "sg-1", nil, func() (interface{}, error) {
+			calls++
+			return nil, nil
+		})
+	}
+
+	if calls != 2 {
+		t.Errorf("got %d calls; want 2", calls)
+	}
+
+	cache.Invalidate(ec2.ServiceID, "")
+}
+
+func TestIsMutating(t *testing.T) {
+	for operation, want := range map[string]bool{
+		"AuthorizeSecurityGroupIngress": true,
+		"CreateRoute":                   true,
+		"DescribeRouteTables":           false,
+		"GetRole":                       false,
+		"ListAttachedRolePolicies":      false,
+	} {
+		if got := IsMutating(operation); got != want {
+			t.Errorf("IsMutating(%q) = %t; want %t", operation, got, want)
+		}
+	}
+}
+
+func TestParentID(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{
+			name:  "security group",
+			input: &ec2.AuthorizeSecurityGroupIngressInput{GroupId: aws.String("sg-1")},
+			want:  "sg-1",
+		},
+		{
+			name:  "route table",
+			input: &ec2.CreateRouteInput{RouteTableId: aws.String("rtb-1")},
+			want:  "rtb-1",
+		},
+		{
+			name:  "role",
+			input: &iam.AttachRolePolicyInput{RoleName: aws.String("role")},
+			want:  "role",
+		},
+		{
+			name:  "unknown",
+			input: &ec2.CreateTagsInput{Resources: aws.StringSlice([]string{"sg-1"})},
+			want:  "",
+		},
+		{
+			name:  "nil",
+			input: nil,
+			want:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := ParentID(testCase.input); got != testCase.want {
+				t.Errorf("got %q; want %q", got, testCase.want)
+			}
+		})
+	}
+}
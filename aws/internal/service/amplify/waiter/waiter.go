@@ -0,0 +1,67 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Domain Association to be created
+	DomainAssociationCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Domain Association's certificate to be verified
+	DomainAssociationVerifiedTimeout = 15 * time.Minute
+)
+
+// DomainAssociationCreated waits for a Domain Association to return PENDING_VERIFICATION, PENDING_DEPLOYMENT or AVAILABLE
+func DomainAssociationCreated(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{amplify.DomainStatusCreating, amplify.DomainStatusInProgress, amplify.DomainStatusRequestingCertificate},
+		Target:  []string{amplify.DomainStatusPendingVerification, amplify.DomainStatusPendingDeployment, amplify.DomainStatusAvailable},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		return v, domainAssociationError(v, err)
+	}
+
+	return nil, err
+}
+
+// DomainAssociationVerified waits for a Domain Association's certificate to be verified, i.e. for it to return PENDING_DEPLOYMENT or AVAILABLE
+func DomainAssociationVerified(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{amplify.DomainStatusUpdating, amplify.DomainStatusInProgress, amplify.DomainStatusPendingVerification},
+		Target:  []string{amplify.DomainStatusPendingDeployment, amplify.DomainStatusAvailable},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationVerifiedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		return v, domainAssociationError(v, err)
+	}
+
+	return nil, err
+}
+
+// domainAssociationError appends the Domain Association's status reason to an error if the association failed
+func domainAssociationError(domainAssociation *amplify.DomainAssociation, err error) error {
+	if err == nil || aws.StringValue(domainAssociation.DomainStatus) != amplify.DomainStatusFailed {
+		return err
+	}
+
+	if reason := aws.StringValue(domainAssociation.StatusReason); reason != "" {
+		return fmt.Errorf("%w: %s", err, reason)
+	}
+
+	return err
+}
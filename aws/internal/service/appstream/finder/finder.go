@@ -0,0 +1,135 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/lister"
+)
+
+// FleetByName returns the Fleet corresponding to the specified name.
+func FleetByName(conn *appstream.AppStream, name string) (*appstream.Fleet, error) {
+	input := &appstream.DescribeFleetsInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeFleets(input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Fleets) == 0 || output.Fleets[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Fleets[0], nil
+}
+
+// FleetStackAssociationByTwoPartKey returns the name of the Stack associated with the specified Fleet.
+func FleetStackAssociationByTwoPartKey(conn *appstream.AppStream, fleetName, stackName string) (string, error) {
+	input := &appstream.ListAssociatedStacksInput{
+		FleetName: aws.String(fleetName),
+	}
+	var result string
+
+	err := lister.ListAssociatedStacksPages(conn, input, func(page *appstream.ListAssociatedStacksOutput, lastPage bool) bool {
+		for _, name := range page.Names {
+			if aws.StringValue(name) == stackName {
+				result = stackName
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if result == "" {
+		return "", &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// ImageBuilderByName returns the Image Builder corresponding to the specified name.
+func ImageBuilderByName(conn *appstream.AppStream, name string) (*appstream.ImageBuilder, error) {
+	input := &appstream.DescribeImageBuildersInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeImageBuilders(input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ImageBuilders) == 0 || output.ImageBuilders[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ImageBuilders[0], nil
+}
+
+// StackByName returns the Stack corresponding to the specified name.
+func StackByName(conn *appstream.AppStream, name string) (*appstream.Stack, error) {
+	input := &appstream.DescribeStacksInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeStacks(input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Stacks) == 0 || output.Stacks[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Stacks[0], nil
+}
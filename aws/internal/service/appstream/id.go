@@ -0,0 +1,25 @@
+package appstream
+
+import (
+	"fmt"
+	"strings"
+)
+
+const fleetStackAssociationResourceIDSeparator = "/"
+
+func FleetStackAssociationCreateResourceID(fleetName, stackName string) string {
+	parts := []string{fleetName, stackName}
+	id := strings.Join(parts, fleetStackAssociationResourceIDSeparator)
+
+	return id
+}
+
+func FleetStackAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, fleetStackAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FLEETNAME%[2]sSTACKNAME", id, fleetStackAssociationResourceIDSeparator)
+}
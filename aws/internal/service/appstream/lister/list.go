@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=DescribeFleets,DescribeImageBuilders,DescribeStacks,ListAssociatedStacks github.com/aws/aws-sdk-go/service/appstream
+
+package lister
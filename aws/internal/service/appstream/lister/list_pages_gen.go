@@ -0,0 +1,94 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=DescribeFleets,DescribeImageBuilders,DescribeStacks,ListAssociatedStacks github.com/aws/aws-sdk-go/service/appstream"; DO NOT EDIT.
+
+package lister
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+)
+
+func DescribeFleetsPages(conn *appstream.AppStream, input *appstream.DescribeFleetsInput, fn func(*appstream.DescribeFleetsOutput, bool) bool) error {
+	return DescribeFleetsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func DescribeFleetsPagesWithContext(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeFleetsInput, fn func(*appstream.DescribeFleetsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeFleetsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func DescribeImageBuildersPages(conn *appstream.AppStream, input *appstream.DescribeImageBuildersInput, fn func(*appstream.DescribeImageBuildersOutput, bool) bool) error {
+	return DescribeImageBuildersPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func DescribeImageBuildersPagesWithContext(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeImageBuildersInput, fn func(*appstream.DescribeImageBuildersOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeImageBuildersWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func DescribeStacksPages(conn *appstream.AppStream, input *appstream.DescribeStacksInput, fn func(*appstream.DescribeStacksOutput, bool) bool) error {
+	return DescribeStacksPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func DescribeStacksPagesWithContext(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeStacksInput, fn func(*appstream.DescribeStacksOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeStacksWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func ListAssociatedStacksPages(conn *appstream.AppStream, input *appstream.ListAssociatedStacksInput, fn func(*appstream.ListAssociatedStacksOutput, bool) bool) error {
+	return ListAssociatedStacksPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListAssociatedStacksPagesWithContext(ctx context.Context, conn *appstream.AppStream, input *appstream.ListAssociatedStacksInput, fn func(*appstream.ListAssociatedStacksOutput, bool) bool) error {
+	for {
+		output, err := conn.ListAssociatedStacksWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
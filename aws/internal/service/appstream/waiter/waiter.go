@@ -0,0 +1,130 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Fleet to start running
+	FleetRunningTimeout = 180 * time.Minute
+
+	// Maximum amount of time to wait for a Fleet to stop
+	FleetStoppedTimeout = 180 * time.Minute
+
+	// Maximum amount of time to wait for an Image Builder to be created
+	ImageBuilderCreatedTimeout = 60 * time.Minute
+
+	// Maximum amount of time to wait for an Image Builder to be deleted
+	ImageBuilderDeletedTimeout = 60 * time.Minute
+)
+
+// FleetRunning waits for a Fleet to return RUNNING
+func FleetRunning(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStarting},
+		Target:  []string{appstream.FleetStateRunning},
+		Refresh: FleetState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.Fleet); ok {
+		if err != nil {
+			err = fleetErrorsError(err, v.FleetErrors)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// FleetStopped waits for a Fleet to return STOPPED
+func FleetStopped(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStopping},
+		Target:  []string{appstream.FleetStateStopped},
+		Refresh: FleetState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.Fleet); ok {
+		if err != nil {
+			err = fleetErrorsError(err, v.FleetErrors)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ImageBuilderCreated waits for an Image Builder to return RUNNING
+func ImageBuilderCreated(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.ImageBuilderStatePending, appstream.ImageBuilderStateUpdatingAgent},
+		Target:  []string{appstream.ImageBuilderStateRunning},
+		Refresh: ImageBuilderState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		if err != nil && v.StateChangeReason != nil {
+			if msg := aws.StringValue(v.StateChangeReason.Message); msg != "" {
+				err = fmt.Errorf("%w: %s", err, msg)
+			}
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ImageBuilderDeleted waits for an Image Builder to be deleted
+func ImageBuilderDeleted(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.ImageBuilderStateDeleting, appstream.ImageBuilderStateStopping},
+		Target:  []string{},
+		Refresh: ImageBuilderState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// fleetErrorsError appends any fleet errors to the specified error.
+func fleetErrorsError(err error, apiObjects []*appstream.FleetError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	if errs == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", err, errs)
+}
@@ -0,0 +1,67 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DomainByName returns the Domain corresponding to the specified name.
+// Domains that are being deleted are treated as not found.
+func DomainByName(conn *cloudsearch.CloudSearch, name string) (*cloudsearch.DomainStatus, error) {
+	input := &cloudsearch.DescribeDomainsInput{
+		DomainNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeDomains(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DomainStatusList) == 0 || output.DomainStatusList[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if aws.BoolValue(output.DomainStatusList[0].Deleted) {
+		return nil, &resource.NotFoundError{
+			Message:     "Domain is deleted",
+			LastRequest: input,
+		}
+	}
+
+	return output.DomainStatusList[0], nil
+}
+
+// ServiceAccessPoliciesByDomainName returns the access policies of the Domain corresponding to the specified name.
+func ServiceAccessPoliciesByDomainName(conn *cloudsearch.CloudSearch, name string) (*cloudsearch.AccessPoliciesStatus, error) {
+	input := &cloudsearch.DescribeServiceAccessPoliciesInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeServiceAccessPolicies(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudsearch.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessPolicies == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.AccessPolicies, nil
+}
@@ -0,0 +1,49 @@
+package waiter
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudsearch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DomainProcessing fetches the Domain and whether it is processing configuration changes
+func DomainProcessing(conn *cloudsearch.CloudSearch, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DomainByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strconv.FormatBool(aws.BoolValue(output.Processing)), nil
+	}
+}
+
+// ServiceAccessPoliciesState fetches the Domain's access policies and their State
+func ServiceAccessPoliciesState(conn *cloudsearch.CloudSearch, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ServiceAccessPoliciesByDomainName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
@@ -0,0 +1,58 @@
+package waiter
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Domain to be created
+	DomainCreatedTimeout = 30 * time.Minute
+
+	// Maximum amount of time to wait for a Domain to be updated
+	DomainUpdatedTimeout = 30 * time.Minute
+
+	// Maximum amount of time to wait for a Domain's access policies to be updated
+	ServiceAccessPoliciesUpdatedTimeout = 20 * time.Minute
+)
+
+// DomainActive waits for a Domain to finish processing configuration changes
+func DomainActive(conn *cloudsearch.CloudSearch, name string, timeout time.Duration) (*cloudsearch.DomainStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.FormatBool(true)},
+		Target:  []string{strconv.FormatBool(false)},
+		Refresh: DomainProcessing(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudsearch.DomainStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ServiceAccessPoliciesActive waits for a Domain's access policies to return Active
+func ServiceAccessPoliciesActive(conn *cloudsearch.CloudSearch, name string, timeout time.Duration) (*cloudsearch.AccessPoliciesStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudsearch.OptionStateProcessing},
+		Target:  []string{cloudsearch.OptionStateActive},
+		Refresh: ServiceAccessPoliciesState(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudsearch.AccessPoliciesStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
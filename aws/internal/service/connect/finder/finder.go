@@ -0,0 +1,97 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ContactFlowByTwoPartKey returns the Contact Flow corresponding to the specified instance ID and contact flow ID.
+func ContactFlowByTwoPartKey(conn *connect.Connect, instanceID, contactFlowID string) (*connect.ContactFlow, error) {
+	input := &connect.DescribeContactFlowInput{
+		ContactFlowId: aws.String(contactFlowID),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeContactFlow(input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactFlow == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ContactFlow, nil
+}
+
+// InstanceAttributeByTwoPartKey returns the value of the specified attribute of the Instance corresponding to the specified ID.
+func InstanceAttributeByTwoPartKey(conn *connect.Connect, instanceID, attributeType string) (string, error) {
+	input := &connect.DescribeInstanceAttributeInput{
+		AttributeType: aws.String(attributeType),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeInstanceAttribute(input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Attribute == nil {
+		return "", &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return aws.StringValue(output.Attribute.Value), nil
+}
+
+// InstanceByID returns the Instance corresponding to the specified ID.
+func InstanceByID(conn *connect.Connect, id string) (*connect.Instance, error) {
+	input := &connect.DescribeInstanceInput{
+		InstanceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInstance(input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Instance == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Instance, nil
+}
@@ -0,0 +1,60 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Instance to be created
+	InstanceCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an Instance to be deleted
+	InstanceDeletedTimeout = 5 * time.Minute
+)
+
+// InstanceCreated waits for an Instance to return ACTIVE
+func InstanceCreated(conn *connect.Connect, id string, timeout time.Duration) (*connect.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connect.InstanceStatusCreationInProgress},
+		Target:  []string{connect.InstanceStatusActive},
+		Refresh: InstanceStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*connect.Instance); ok {
+		if err != nil && v.StatusReason != nil {
+			if msg := aws.StringValue(v.StatusReason.Message); msg != "" {
+				err = fmt.Errorf("%w: %s", err, msg)
+			}
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InstanceDeleted waits for an Instance to be deleted
+func InstanceDeleted(conn *connect.Connect, id string, timeout time.Duration) (*connect.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connect.InstanceStatusActive},
+		Target:  []string{},
+		Refresh: InstanceStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*connect.Instance); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -3,6 +3,7 @@ package finder
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/readcache"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
@@ -74,17 +75,58 @@ func ClientVpnRouteByID(conn *ec2.EC2, routeID string) (*ec2.DescribeClientVpnRo
 	return ClientVpnRoute(conn, endpointID, targetSubnetID, destinationCidr)
 }
 
+// RouteTableByID returns the route table corresponding to the specified identifier.
+// Returns nil and potentially an error if no route table is found.
+func RouteTableByID(conn *ec2.EC2, id string) (*ec2.RouteTable, error) {
+	return RouteTableByIDWithCache(conn, nil, id)
+}
+
+// RouteTableByIDWithCache returns the route table corresponding to the specified identifier,
+// reading the response through the given cache, which may be nil.
+// Returns nil and potentially an error if no route table is found.
+func RouteTableByIDWithCache(conn *ec2.EC2, cache *readcache.Cache, id string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		RouteTableIds: aws.StringSlice([]string{id}),
+	}
+
+	outputRaw, err := cache.Get(ec2.ServiceID, "DescribeRouteTables", id, input, func() (interface{}, error) {
+		return conn.DescribeRouteTables(input)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output, ok := outputRaw.(*ec2.DescribeRouteTablesOutput)
+
+	if !ok || output == nil || len(output.RouteTables) == 0 || output.RouteTables[0] == nil {
+		return nil, nil
+	}
+
+	return output.RouteTables[0], nil
+}
+
 // SecurityGroupByID looks up a security group by ID. When not found, returns nil and potentially an API error.
 func SecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
+	return SecurityGroupByIDWithCache(conn, nil, id)
+}
+
+// SecurityGroupByIDWithCache looks up a security group by ID, reading the response through the given cache,
+// which may be nil. When not found, returns nil and potentially an API error.
+func SecurityGroupByIDWithCache(conn *ec2.EC2, cache *readcache.Cache, id string) (*ec2.SecurityGroup, error) {
 	req := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: aws.StringSlice([]string{id}),
 	}
-	result, err := conn.DescribeSecurityGroups(req)
+
+	resultRaw, err := cache.Get(ec2.ServiceID, "DescribeSecurityGroups", id, req, func() (interface{}, error) {
+		return conn.DescribeSecurityGroups(req)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if result == nil || len(result.SecurityGroups) == 0 || result.SecurityGroups[0] == nil {
+	result, ok := resultRaw.(*ec2.DescribeSecurityGroupsOutput)
+
+	if !ok || result == nil || len(result.SecurityGroups) == 0 || result.SecurityGroups[0] == nil {
 		return nil, nil
 	}
 
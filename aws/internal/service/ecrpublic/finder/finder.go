@@ -0,0 +1,95 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// RepositoryByName returns the ECR Public Repository corresponding to the specified name.
+func RepositoryByName(conn *ecrpublic.ECRPublic, name string) (*ecrpublic.Repository, error) {
+	input := &ecrpublic.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeRepositories(input)
+
+	if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Repositories) == 0 || output.Repositories[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Repositories[0], nil
+}
+
+// RepositoryCatalogDataByName returns the catalog data of the ECR Public Repository corresponding to the specified name.
+func RepositoryCatalogDataByName(conn *ecrpublic.ECRPublic, name string) (*ecrpublic.RepositoryCatalogData, error) {
+	input := &ecrpublic.GetRepositoryCatalogDataInput{
+		RepositoryName: aws.String(name),
+	}
+
+	output, err := conn.GetRepositoryCatalogData(input)
+
+	if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CatalogData == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.CatalogData, nil
+}
+
+// RepositoryPolicyByName returns the policy of the ECR Public Repository corresponding to the specified name.
+func RepositoryPolicyByName(conn *ecrpublic.ECRPublic, name string) (*ecrpublic.GetRepositoryPolicyOutput, error) {
+	input := &ecrpublic.GetRepositoryPolicyInput{
+		RepositoryName: aws.String(name),
+	}
+
+	output, err := conn.GetRepositoryPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) || tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryPolicyNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PolicyText == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
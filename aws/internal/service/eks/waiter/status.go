@@ -0,0 +1,69 @@
+package waiter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ClusterStatusUnknown = "Unknown"
+
+	UpdateStatusUnknown = "Unknown"
+)
+
+// ClusterStatus fetches the Cluster and its Status
+func ClusterStatus(ctx context.Context, conn *eks.EKS, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &eks.DescribeClusterInput{
+			Name: aws.String(name),
+		}
+
+		output, err := conn.DescribeClusterWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		// Sometimes the EKS API returns the ResourceNotFound error in this form:
+		// ClientException: No cluster found for name: tf-acc-test-0o1f8
+		if tfawserr.ErrMessageContains(err, eks.ErrCodeClientException, "No cluster found for name:") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, ClusterStatusUnknown, err
+		}
+
+		if output == nil || output.Cluster == nil {
+			return nil, "", nil
+		}
+
+		return output.Cluster, aws.StringValue(output.Cluster.Status), nil
+	}
+}
+
+// ClusterUpdateStatus fetches the Cluster Update and its Status
+func ClusterUpdateStatus(ctx context.Context, conn *eks.EKS, name, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &eks.DescribeUpdateInput{
+			Name:     aws.String(name),
+			UpdateId: aws.String(id),
+		}
+
+		output, err := conn.DescribeUpdateWithContext(ctx, input)
+
+		if err != nil {
+			return nil, UpdateStatusUnknown, err
+		}
+
+		if output == nil || output.Update == nil {
+			return nil, UpdateStatusUnknown, nil
+		}
+
+		return output.Update, aws.StringValue(output.Update.Status), nil
+	}
+}
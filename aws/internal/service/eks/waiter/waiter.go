@@ -0,0 +1,85 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ClusterCreated waits for a Cluster to return Active
+func ClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusCreating},
+		Target:  []string{eks.ClusterStatusActive},
+		Refresh: ClusterStatus(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*eks.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ClusterDeleted waits for a Cluster to be deleted
+func ClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: ClusterStatus(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*eks.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ClusterUpdateSuccessful waits for a Cluster Update to return Successful
+func ClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.UpdateStatusInProgress},
+		Target: []string{
+			eks.UpdateStatusCancelled,
+			eks.UpdateStatusFailed,
+			eks.UpdateStatusSuccessful,
+		},
+		Refresh: ClusterUpdateStatus(ctx, conn, name, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	update, ok := outputRaw.(*eks.Update)
+
+	if !ok {
+		return nil, fmt.Errorf("EKS Cluster (%s) update (%s) missing", name, id)
+	}
+
+	if aws.StringValue(update.Status) == eks.UpdateStatusSuccessful {
+		return update, nil
+	}
+
+	var detailedErrors []string
+	for i, updateError := range update.Errors {
+		detailedErrors = append(detailedErrors, fmt.Sprintf("Error %d: Code: %s / Message: %s", i+1, aws.StringValue(updateError.ErrorCode), aws.StringValue(updateError.ErrorMessage)))
+	}
+
+	return update, fmt.Errorf("EKS Cluster (%s) update (%s) status (%s) not successful: Errors:\n%s", name, id, aws.StringValue(update.Status), strings.Join(detailedErrors, "\n"))
+}
@@ -0,0 +1,38 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/readcache"
+)
+
+// RoleAttachedPolicies returns the managed policies attached to the specified role,
+// reading the response through the given cache, which may be nil.
+func RoleAttachedPolicies(conn *iam.IAM, cache *readcache.Cache, roleName string) ([]*iam.AttachedPolicy, error) {
+	input := &iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+
+	outputRaw, err := cache.Get(iam.ServiceID, "ListAttachedRolePolicies", roleName, input, func() (interface{}, error) {
+		var policies []*iam.AttachedPolicy
+
+		err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			policies = append(policies, page.AttachedPolicies...)
+
+			return !lastPage
+		})
+
+		return policies, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies, _ := outputRaw.([]*iam.AttachedPolicy)
+
+	return policies, nil
+}
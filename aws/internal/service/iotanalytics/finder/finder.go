@@ -0,0 +1,124 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ChannelByName returns the Channel corresponding to the specified name.
+func ChannelByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Channel, error) {
+	input := &iotanalytics.DescribeChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeChannel(input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Channel == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Channel, nil
+}
+
+// DatasetByName returns the Dataset corresponding to the specified name.
+func DatasetByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Dataset, error) {
+	input := &iotanalytics.DescribeDatasetInput{
+		DatasetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDataset(input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dataset == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Dataset, nil
+}
+
+// DatastoreByName returns the Datastore corresponding to the specified name.
+func DatastoreByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastore(input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Datastore, nil
+}
+
+// PipelineByName returns the Pipeline corresponding to the specified name.
+func PipelineByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipeline(input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Pipeline, nil
+}
@@ -0,0 +1,121 @@
+package equivalency
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+)
+
+type detectorModelDefinition iotevents.DetectorModelDefinition
+
+func (dmd *detectorModelDefinition) Reduce() error {
+	for _, state := range dmd.States {
+		if state == nil {
+			continue
+		}
+
+		// Prevent difference of API response that adds empty lifecycles when not configured during the request
+		if state.OnEnter != nil {
+			state.OnEnter.Events = reduceEvents(state.OnEnter.Events)
+
+			if state.OnEnter.Events == nil {
+				state.OnEnter = nil
+			}
+		}
+
+		if state.OnExit != nil {
+			state.OnExit.Events = reduceEvents(state.OnExit.Events)
+
+			if state.OnExit.Events == nil {
+				state.OnExit = nil
+			}
+		}
+
+		if state.OnInput != nil {
+			state.OnInput.Events = reduceEvents(state.OnInput.Events)
+
+			for _, transitionEvent := range state.OnInput.TransitionEvents {
+				if transitionEvent != nil && len(transitionEvent.Actions) == 0 {
+					transitionEvent.Actions = nil
+				}
+			}
+
+			if len(state.OnInput.TransitionEvents) == 0 {
+				state.OnInput.TransitionEvents = nil
+			}
+
+			if state.OnInput.Events == nil && state.OnInput.TransitionEvents == nil {
+				state.OnInput = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func reduceEvents(events []*iotevents.Event) []*iotevents.Event {
+	// Prevent difference of API response that adds an empty array when not configured during the request
+	if len(events) == 0 {
+		return nil
+	}
+
+	for _, event := range events {
+		if event != nil && len(event.Actions) == 0 {
+			event.Actions = nil
+		}
+	}
+
+	return events
+}
+
+// EquivalentIotEventsDetectorModelDefinitionJSON determines equality between two IoT Events DetectorModelDefinition JSON strings
+func EquivalentIotEventsDetectorModelDefinitionJSON(str1, str2 string) (bool, error) {
+	if str1 == "" {
+		str1 = "{}"
+	}
+
+	if str2 == "" {
+		str2 = "{}"
+	}
+
+	var dmd1, dmd2 detectorModelDefinition
+
+	if err := json.Unmarshal([]byte(str1), &dmd1); err != nil {
+		return false, err
+	}
+
+	if err := dmd1.Reduce(); err != nil {
+		return false, err
+	}
+
+	canonicalJson1, err := jsonutil.BuildJSON(dmd1)
+
+	if err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal([]byte(str2), &dmd2); err != nil {
+		return false, err
+	}
+
+	if err := dmd2.Reduce(); err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := jsonutil.BuildJSON(dmd2)
+
+	if err != nil {
+		return false, err
+	}
+
+	equal := bytes.Equal(canonicalJson1, canonicalJson2)
+
+	if !equal {
+		log.Printf("[DEBUG] Canonical IoT Events Detector Model Definition JSON are not equal.\nFirst: %s\nSecond: %s\n", canonicalJson1, canonicalJson2)
+	}
+
+	return equal, nil
+}
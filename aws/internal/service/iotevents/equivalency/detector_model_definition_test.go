@@ -0,0 +1,232 @@
+package equivalency
+
+import (
+	"testing"
+)
+
+func TestEquivalentIotEventsDetectorModelDefinitionJSON(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		ApiJson           string
+		ConfigurationJson string
+		ExpectEquivalent  bool
+		ExpectError       bool
+	}{
+		{
+			Name:              "empty",
+			ApiJson:           ``,
+			ConfigurationJson: ``,
+			ExpectEquivalent:  true,
+		},
+		{
+			Name: "reordered keys and whitespace",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onInput": {
+				"transitionEvents": [
+					{
+						"eventName": "Overheated",
+						"condition": "$input.temperature.value > 30",
+						"nextState": "Alarm"
+					}
+				]
+			}
+		}
+	]
+}
+`,
+			ConfigurationJson: `{"states":[{"onInput":{"transitionEvents":[{"nextState":"Alarm","condition":"$input.temperature.value > 30","eventName":"Overheated"}]},"stateName":"Normal"}],"initialStateName":"Normal"}`,
+			ExpectEquivalent:  true,
+		},
+		{
+			Name: "empty lifecycles and actions",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onEnter": {
+				"events": []
+			},
+			"onExit": {
+				"events": []
+			},
+			"onInput": {
+				"events": [
+					{
+						"eventName": "Reset",
+						"condition": "true",
+						"actions": []
+					}
+				],
+				"transitionEvents": []
+			}
+		},
+		{
+			"stateName": "Alarm",
+			"onEnter": {
+				"events": []
+			},
+			"onExit": {
+				"events": []
+			},
+			"onInput": {
+				"events": [],
+				"transitionEvents": []
+			}
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onInput": {
+				"events": [
+					{
+						"eventName": "Reset",
+						"condition": "true"
+					}
+				]
+			}
+		},
+		{
+			"stateName": "Alarm"
+		}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "different condition",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onInput": {
+				"transitionEvents": [
+					{
+						"eventName": "Overheated",
+						"condition": "$input.temperature.value > 30",
+						"nextState": "Normal"
+					}
+				]
+			}
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onInput": {
+				"transitionEvents": [
+					{
+						"eventName": "Overheated",
+						"condition": "$input.temperature.value > 40",
+						"nextState": "Normal"
+					}
+				]
+			}
+		}
+	]
+}
+`,
+			ExpectEquivalent: false,
+		},
+		{
+			Name: "different action",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onEnter": {
+				"events": [
+					{
+						"eventName": "Init",
+						"condition": "true",
+						"actions": [
+							{
+								"setVariable": {
+									"variableName": "count",
+									"value": "0"
+								}
+							}
+						]
+					}
+				]
+			}
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onEnter": {
+				"events": [
+					{
+						"eventName": "Init",
+						"condition": "true",
+						"actions": [
+							{
+								"setVariable": {
+									"variableName": "count",
+									"value": "1"
+								}
+							}
+						]
+					}
+				]
+			}
+		}
+	]
+}
+`,
+			ExpectEquivalent: false,
+		},
+		{
+			Name:              "invalid JSON",
+			ApiJson:           `{}`,
+			ConfigurationJson: `{"states":`,
+			ExpectError:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := EquivalentIotEventsDetectorModelDefinitionJSON(testCase.ConfigurationJson, testCase.ApiJson)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+
+			if got != testCase.ExpectEquivalent {
+				t.Errorf("got %t, expected %t", got, testCase.ExpectEquivalent)
+			}
+		})
+	}
+}
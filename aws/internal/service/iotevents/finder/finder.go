@@ -0,0 +1,66 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DetectorModelByName returns the latest version of the Detector Model corresponding to the specified name.
+func DetectorModelByName(conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModel(input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.DetectorModel, nil
+}
+
+// InputByName returns the Input corresponding to the specified name.
+func InputByName(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	input := &iotevents.DescribeInputInput{
+		InputName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInput(input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Input == nil || output.Input.InputConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Input, nil
+}
@@ -0,0 +1,94 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Detector Model version to become active
+	DetectorModelActiveTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Detector Model to be deleted
+	DetectorModelDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for an Input to become active
+	InputActiveTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an Input to be deleted
+	InputDeletedTimeout = 5 * time.Minute
+)
+
+// DetectorModelActive waits for the latest version of a Detector Model to return ACTIVE
+func DetectorModelActive(conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: DetectorModelStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DetectorModelDeleted waits for a Detector Model to be deleted
+func DetectorModelDeleted(conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: DetectorModelStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InputActive waits for an Input to return ACTIVE
+func InputActive(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.InputStatusCreating, iotevents.InputStatusUpdating},
+		Target:  []string{iotevents.InputStatusActive},
+		Refresh: InputStatus(conn, name),
+		Timeout: InputActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.Input); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InputDeleted waits for an Input to be deleted
+func InputDeleted(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.InputStatusDeleting},
+		Target:  []string{},
+		Refresh: InputStatus(conn, name),
+		Timeout: InputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.Input); ok {
+		return v, err
+	}
+
+	return nil, err
+}
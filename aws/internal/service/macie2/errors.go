@@ -0,0 +1,6 @@
+package macie2
+
+const (
+	// Returned as the message of an AccessDeniedException by every operation when Macie is disabled in the account
+	ErrMessageMacieNotEnabled = "Macie is not enabled"
+)
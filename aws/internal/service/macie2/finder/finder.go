@@ -0,0 +1,200 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+)
+
+// notFound returns whether an error means the requested Macie resource, or Macie itself, does not exist.
+func notFound(err error) bool {
+	return tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled)
+}
+
+// ClassificationJobByID retrieves a Macie Classification Job by ID.
+func ClassificationJobByID(conn *macie2.Macie2, id string) (*macie2.DescribeClassificationJobOutput, error) {
+	input := &macie2.DescribeClassificationJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeClassificationJob(input)
+
+	if notFound(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// CustomDataIdentifierByID retrieves a Macie Custom Data Identifier by ID.
+// Deleted identifiers are reported as not found.
+func CustomDataIdentifierByID(conn *macie2.Macie2, id string) (*macie2.GetCustomDataIdentifierOutput, error) {
+	input := &macie2.GetCustomDataIdentifierInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetCustomDataIdentifier(input)
+
+	if notFound(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.BoolValue(output.Deleted) {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindingsFilterByID retrieves a Macie Findings Filter by ID.
+func FindingsFilterByID(conn *macie2.Macie2, id string) (*macie2.GetFindingsFilterOutput, error) {
+	input := &macie2.GetFindingsFilterInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetFindingsFilter(input)
+
+	if notFound(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// MacieSession retrieves the Macie session of the current account.
+func MacieSession(conn *macie2.Macie2) (*macie2.GetMacieSessionOutput, error) {
+	input := &macie2.GetMacieSessionInput{}
+
+	output, err := conn.GetMacieSession(input)
+
+	if notFound(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// MemberByAccountID retrieves a Macie Member by account ID.
+func MemberByAccountID(conn *macie2.Macie2, accountID string) (*macie2.GetMemberOutput, error) {
+	input := &macie2.GetMemberInput{
+		Id: aws.String(accountID),
+	}
+
+	output, err := conn.GetMember(input)
+
+	if notFound(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// OrganizationAdminAccountByID retrieves an enabled Macie Organization Admin Account by account ID.
+func OrganizationAdminAccountByID(conn *macie2.Macie2, accountID string) (*macie2.AdminAccount, error) {
+	input := &macie2.ListOrganizationAdminAccountsInput{}
+	var result *macie2.AdminAccount
+
+	err := conn.ListOrganizationAdminAccountsPages(input, func(page *macie2.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		for _, adminAccount := range page.AdminAccounts {
+			if adminAccount == nil {
+				continue
+			}
+
+			if aws.StringValue(adminAccount.AccountId) == accountID && aws.StringValue(adminAccount.Status) == macie2.AdminStatusEnabled {
+				result = adminAccount
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if notFound(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
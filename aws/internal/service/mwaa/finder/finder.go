@@ -0,0 +1,37 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// EnvironmentByName retrieves an MWAA Environment by name.
+func EnvironmentByName(conn *mwaa.MWAA, name string) (*mwaa.Environment, error) {
+	input := &mwaa.GetEnvironmentInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEnvironment(input)
+
+	if tfawserr.ErrCodeEquals(err, mwaa.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Environment == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Environment, nil
+}
@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mwaa/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// EnvironmentStatus fetches the Environment and its Status
+func EnvironmentStatus(conn *mwaa.MWAA, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		environment, err := finder.EnvironmentByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return environment, aws.StringValue(environment.Status), nil
+	}
+}
@@ -0,0 +1,88 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Environment to be created
+	EnvironmentCreatedTimeout = 90 * time.Minute
+
+	// Maximum amount of time to wait for an Environment to be updated
+	EnvironmentUpdatedTimeout = 90 * time.Minute
+
+	// Maximum amount of time to wait for an Environment to be deleted
+	EnvironmentDeletedTimeout = 90 * time.Minute
+)
+
+// EnvironmentCreated waits for an Environment to return AVAILABLE
+func EnvironmentCreated(conn *mwaa.MWAA, name string) (*mwaa.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mwaa.EnvironmentStatusCreating},
+		Target:  []string{mwaa.EnvironmentStatusAvailable},
+		Refresh: EnvironmentStatus(conn, name),
+		Timeout: EnvironmentCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*mwaa.Environment); ok {
+		return v, environmentError(v, err)
+	}
+
+	return nil, err
+}
+
+// EnvironmentUpdated waits for an Environment to return AVAILABLE
+func EnvironmentUpdated(conn *mwaa.MWAA, name string) (*mwaa.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mwaa.EnvironmentStatusUpdating},
+		Target:  []string{mwaa.EnvironmentStatusAvailable},
+		Refresh: EnvironmentStatus(conn, name),
+		Timeout: EnvironmentUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*mwaa.Environment); ok {
+		if err == nil && v.LastUpdate != nil && aws.StringValue(v.LastUpdate.Status) == mwaa.UpdateStatusFailed {
+			err = fmt.Errorf("update failed")
+		}
+
+		return v, environmentError(v, err)
+	}
+
+	return nil, err
+}
+
+// EnvironmentDeleted waits for an Environment to be deleted
+func EnvironmentDeleted(conn *mwaa.MWAA, name string) (*mwaa.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mwaa.EnvironmentStatusDeleting},
+		Target:  []string{},
+		Refresh: EnvironmentStatus(conn, name),
+		Timeout: EnvironmentDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*mwaa.Environment); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// environmentError adds the error of the last update, if any, to err.
+func environmentError(environment *mwaa.Environment, err error) error {
+	if err == nil || environment.LastUpdate == nil || environment.LastUpdate.Error == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s: %s", err, aws.StringValue(environment.LastUpdate.Error.ErrorCode), aws.StringValue(environment.LastUpdate.Error.ErrorMessage))
+}
@@ -0,0 +1,246 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// CustomerGatewayAssociationByTwoPartKey returns the Customer Gateway Association corresponding to the specified global network ID and customer gateway ARN.
+func CustomerGatewayAssociationByTwoPartKey(conn *networkmanager.NetworkManager, globalNetworkID, customerGatewayARN string) (*networkmanager.CustomerGatewayAssociation, error) {
+	input := &networkmanager.GetCustomerGatewayAssociationsInput{
+		CustomerGatewayArns: aws.StringSlice([]string{customerGatewayARN}),
+		GlobalNetworkId:     aws.String(globalNetworkID),
+	}
+	var result *networkmanager.CustomerGatewayAssociation
+
+	err := conn.GetCustomerGatewayAssociationsPages(input, func(page *networkmanager.GetCustomerGatewayAssociationsOutput, lastPage bool) bool {
+		for _, association := range page.CustomerGatewayAssociations {
+			if association == nil {
+				continue
+			}
+
+			if aws.StringValue(association.CustomerGatewayArn) == customerGatewayARN {
+				result = association
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil || aws.StringValue(result.State) == networkmanager.CustomerGatewayAssociationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// DeviceByTwoPartKey returns the Device corresponding to the specified global network ID and device ID.
+func DeviceByTwoPartKey(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	input := &networkmanager.GetDevicesInput{
+		DeviceIds:       aws.StringSlice([]string{deviceID}),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	output, err := conn.GetDevices(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Devices) == 0 || output.Devices[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Devices[0], nil
+}
+
+// GlobalNetworkByID returns the Global Network corresponding to the specified ID.
+func GlobalNetworkByID(conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeGlobalNetworks(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.GlobalNetworks) == 0 || output.GlobalNetworks[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.GlobalNetworks[0], nil
+}
+
+// LinkByTwoPartKey returns the Link corresponding to the specified global network ID and link ID.
+func LinkByTwoPartKey(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	input := &networkmanager.GetLinksInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkIds:         aws.StringSlice([]string{linkID}),
+	}
+
+	output, err := conn.GetLinks(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Links) == 0 || output.Links[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Links[0], nil
+}
+
+// LinkAssociationByThreePartKey returns the Link Association corresponding to the specified global network ID, link ID and device ID.
+func LinkAssociationByThreePartKey(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	input := &networkmanager.GetLinkAssociationsInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	output, err := conn.GetLinkAssociations(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LinkAssociations) == 0 || output.LinkAssociations[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if state := aws.StringValue(output.LinkAssociations[0].LinkAssociationState); state == networkmanager.LinkAssociationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.LinkAssociations[0], nil
+}
+
+// SiteByTwoPartKey returns the Site corresponding to the specified global network ID and site ID.
+func SiteByTwoPartKey(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteIds:         aws.StringSlice([]string{siteID}),
+	}
+
+	output, err := conn.GetSites(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Sites) == 0 || output.Sites[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Sites[0], nil
+}
+
+// TransitGatewayRegistrationByTwoPartKey returns the Transit Gateway Registration corresponding to the specified global network ID and transit gateway ARN.
+func TransitGatewayRegistrationByTwoPartKey(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	input := &networkmanager.GetTransitGatewayRegistrationsInput{
+		GlobalNetworkId:    aws.String(globalNetworkID),
+		TransitGatewayArns: aws.StringSlice([]string{transitGatewayARN}),
+	}
+
+	output, err := conn.GetTransitGatewayRegistrations(input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayRegistrations) == 0 || output.TransitGatewayRegistrations[0] == nil || output.TransitGatewayRegistrations[0].State == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if state := aws.StringValue(output.TransitGatewayRegistrations[0].State.Code); state == networkmanager.TransitGatewayRegistrationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.TransitGatewayRegistrations[0], nil
+}
@@ -0,0 +1,128 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// GlobalNetworkStatus fetches the Global Network and its State
+func GlobalNetworkStatus(conn *networkmanager.NetworkManager, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.GlobalNetworkByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// SiteStatus fetches the Site and its State
+func SiteStatus(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.SiteByTwoPartKey(conn, globalNetworkID, siteID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// DeviceStatus fetches the Device and its State
+func DeviceStatus(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DeviceByTwoPartKey(conn, globalNetworkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// LinkStatus fetches the Link and its State
+func LinkStatus(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.LinkByTwoPartKey(conn, globalNetworkID, linkID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// LinkAssociationStatus fetches the Link Association and its State
+func LinkAssociationStatus(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.LinkAssociationByThreePartKey(conn, globalNetworkID, linkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LinkAssociationState), nil
+	}
+}
+
+// CustomerGatewayAssociationStatus fetches the Customer Gateway Association and its State
+func CustomerGatewayAssociationStatus(conn *networkmanager.NetworkManager, globalNetworkID, customerGatewayARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.CustomerGatewayAssociationByTwoPartKey(conn, globalNetworkID, customerGatewayARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// TransitGatewayRegistrationStatus fetches the Transit Gateway Registration and its State
+func TransitGatewayRegistrationStatus(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.TransitGatewayRegistrationByTwoPartKey(conn, globalNetworkID, transitGatewayARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State.Code), nil
+	}
+}
@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=GetResources -paginator=PaginationToken github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi
+
+package lister
@@ -0,0 +1,31 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=GetResources -paginator=PaginationToken github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"; DO NOT EDIT.
+
+package lister
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+func GetResourcesPages(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, input *resourcegroupstaggingapi.GetResourcesInput, fn func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool) error {
+	return GetResourcesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func GetResourcesPagesWithContext(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, input *resourcegroupstaggingapi.GetResourcesInput, fn func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetResourcesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.PaginationToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.PaginationToken = output.PaginationToken
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DomainDetailByName returns the domain details corresponding to the specified domain name.
+func DomainDetailByName(conn *route53domains.Route53Domains, name string) (*route53domains.GetDomainDetailOutput, error) {
+	input := &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.GetDomainDetail(input)
+
+	if tfawserr.ErrMessageContains(err, route53domains.ErrCodeInvalidInput, "not found") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// OperationDetailByID returns the operation details corresponding to the specified operation ID.
+func OperationDetailByID(conn *route53domains.Route53Domains, id string) (*route53domains.GetOperationDetailOutput, error) {
+	input := &route53domains.GetOperationDetailInput{
+		OperationId: aws.String(id),
+	}
+
+	output, err := conn.GetOperationDetail(input)
+
+	if tfawserr.ErrMessageContains(err, route53domains.ErrCodeInvalidInput, "No operation found") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,33 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// OperationSucceeded waits for an Operation to return SUCCESSFUL, appending the operation message to the error if it failed
+func OperationSucceeded(conn *route53domains.Route53Domains, id string, timeout time.Duration) (*route53domains.GetOperationDetailOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53domains.OperationStatusSubmitted, route53domains.OperationStatusInProgress},
+		Target:  []string{route53domains.OperationStatusSuccessful},
+		Refresh: OperationStatus(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*route53domains.GetOperationDetailOutput); ok {
+		if status := aws.StringValue(output.Status); err != nil && (status == route53domains.OperationStatusError || status == route53domains.OperationStatusFailed) {
+			return output, fmt.Errorf("%w: %s", err, aws.StringValue(output.Message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
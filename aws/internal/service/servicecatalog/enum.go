@@ -0,0 +1,33 @@
+package servicecatalog
+
+const (
+	AcceptLanguageEnglish  = "en"
+	AcceptLanguageJapanese = "jp"
+	AcceptLanguageChinese  = "zh"
+)
+
+func AcceptLanguage_Values() []string {
+	return []string{
+		AcceptLanguageEnglish,
+		AcceptLanguageJapanese,
+		AcceptLanguageChinese,
+	}
+}
+
+const (
+	ConstraintTypeLaunch         = "LAUNCH"
+	ConstraintTypeNotification   = "NOTIFICATION"
+	ConstraintTypeResourceUpdate = "RESOURCE_UPDATE"
+	ConstraintTypeStackset       = "STACKSET"
+	ConstraintTypeTemplate       = "TEMPLATE"
+)
+
+func ConstraintType_Values() []string {
+	return []string{
+		ConstraintTypeLaunch,
+		ConstraintTypeNotification,
+		ConstraintTypeResourceUpdate,
+		ConstraintTypeStackset,
+		ConstraintTypeTemplate,
+	}
+}
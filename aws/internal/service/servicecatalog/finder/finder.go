@@ -0,0 +1,320 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ConstraintByID returns the Constraint corresponding to the specified ID.
+func ConstraintByID(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeConstraintOutput, error) {
+	input := &servicecatalog.DescribeConstraintInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.DescribeConstraint(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConstraintDetail == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// PortfolioShareByThreePartKey returns the Portfolio Share corresponding to the specified portfolio ID, share type and principal ID.
+func PortfolioShareByThreePartKey(conn *servicecatalog.ServiceCatalog, portfolioID, shareType, principalID string) (*servicecatalog.PortfolioShareDetail, error) {
+	input := &servicecatalog.DescribePortfolioSharesInput{
+		PortfolioId: aws.String(portfolioID),
+		Type:        aws.String(shareType),
+	}
+	var result *servicecatalog.PortfolioShareDetail
+
+	err := conn.DescribePortfolioSharesPages(input, func(page *servicecatalog.DescribePortfolioSharesOutput, lastPage bool) bool {
+		for _, share := range page.PortfolioShareDetails {
+			if share == nil {
+				continue
+			}
+
+			if aws.StringValue(share.PrincipalId) == principalID {
+				result = share
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// PortfolioShareStatusByToken returns the status of the organization Portfolio Share operation corresponding to the specified token.
+func PortfolioShareStatusByToken(conn *servicecatalog.ServiceCatalog, token string) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
+	input := &servicecatalog.DescribePortfolioShareStatusInput{
+		PortfolioShareToken: aws.String(token),
+	}
+
+	output, err := conn.DescribePortfolioShareStatus(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// PrincipalPortfolioAssociationByTwoPartKey returns the Principal associated with the specified portfolio ID and principal ARN.
+func PrincipalPortfolioAssociationByTwoPartKey(conn *servicecatalog.ServiceCatalog, acceptLanguage, portfolioID, principalARN string) (*servicecatalog.Principal, error) {
+	input := &servicecatalog.ListPrincipalsForPortfolioInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		PortfolioId:    aws.String(portfolioID),
+	}
+	var result *servicecatalog.Principal
+
+	err := conn.ListPrincipalsForPortfolioPages(input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		for _, principal := range page.Principals {
+			if principal == nil {
+				continue
+			}
+
+			if aws.StringValue(principal.PrincipalARN) == principalARN {
+				result = principal
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// ProductByID returns the Product corresponding to the specified ID.
+func ProductByID(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	input := &servicecatalog.DescribeProductAsAdminInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.DescribeProductAsAdmin(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProductViewDetail == nil || output.ProductViewDetail.ProductViewSummary == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// ProductPortfolioAssociationByTwoPartKey returns the Portfolio associated with the specified portfolio ID and product ID.
+func ProductPortfolioAssociationByTwoPartKey(conn *servicecatalog.ServiceCatalog, acceptLanguage, portfolioID, productID string) (*servicecatalog.PortfolioDetail, error) {
+	input := &servicecatalog.ListPortfoliosForProductInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		ProductId:      aws.String(productID),
+	}
+	var result *servicecatalog.PortfolioDetail
+
+	err := conn.ListPortfoliosForProductPages(input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if portfolio == nil {
+				continue
+			}
+
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				result = portfolio
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// ProvisionedProductByID returns the Provisioned Product corresponding to the specified ID.
+func ProvisionedProductByID(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.ProvisionedProductDetail, error) {
+	input := &servicecatalog.DescribeProvisionedProductInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.DescribeProvisionedProduct(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProvisionedProductDetail == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ProvisionedProductDetail, nil
+}
+
+// ProvisioningArtifactByTwoPartKey returns the Provisioning Artifact corresponding to the specified product ID and provisioning artifact ID.
+func ProvisioningArtifactByTwoPartKey(conn *servicecatalog.ServiceCatalog, acceptLanguage, productID, provisioningArtifactID string) (*servicecatalog.DescribeProvisioningArtifactOutput, error) {
+	input := &servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	output, err := conn.DescribeProvisioningArtifact(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProvisioningArtifactDetail == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// RecordByID returns the Record corresponding to the specified ID.
+func RecordByID(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeRecordOutput, error) {
+	input := &servicecatalog.DescribeRecordInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.DescribeRecord(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RecordDetail == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
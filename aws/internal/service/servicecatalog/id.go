@@ -0,0 +1,83 @@
+package servicecatalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+const portfolioShareResourceIDSeparator = "/"
+
+func PortfolioShareCreateResourceID(portfolioID, shareType, principalID string) string {
+	parts := []string{portfolioID, shareType, principalID}
+	id := strings.Join(parts, portfolioShareResourceIDSeparator)
+
+	return id
+}
+
+func PortfolioShareParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, portfolioShareResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PORTFOLIOID%[2]sTYPE%[2]sPRINCIPALID", id, portfolioShareResourceIDSeparator)
+}
+
+const principalPortfolioAssociationResourceIDSeparator = "/"
+
+func PrincipalPortfolioAssociationCreateResourceID(portfolioID, principalARN string) string {
+	parts := []string{portfolioID, principalARN}
+	id := strings.Join(parts, principalPortfolioAssociationResourceIDSeparator)
+
+	return id
+}
+
+func PrincipalPortfolioAssociationParseResourceID(id string) (string, string, error) {
+	// Principal ARNs may contain the separator, e.g. "arn:aws:iam::123456789012:role/path/name".
+	parts := strings.SplitN(id, principalPortfolioAssociationResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PORTFOLIOID%[2]sPRINCIPALARN", id, principalPortfolioAssociationResourceIDSeparator)
+}
+
+const productPortfolioAssociationResourceIDSeparator = "/"
+
+func ProductPortfolioAssociationCreateResourceID(portfolioID, productID string) string {
+	parts := []string{portfolioID, productID}
+	id := strings.Join(parts, productPortfolioAssociationResourceIDSeparator)
+
+	return id
+}
+
+func ProductPortfolioAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, productPortfolioAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PORTFOLIOID%[2]sPRODUCTID", id, productPortfolioAssociationResourceIDSeparator)
+}
+
+const provisioningArtifactResourceIDSeparator = "/"
+
+func ProvisioningArtifactCreateResourceID(productID, provisioningArtifactID string) string {
+	parts := []string{productID, provisioningArtifactID}
+	id := strings.Join(parts, provisioningArtifactResourceIDSeparator)
+
+	return id
+}
+
+func ProvisioningArtifactParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, provisioningArtifactResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PRODUCTID%[2]sPROVISIONINGARTIFACTID", id, provisioningArtifactResourceIDSeparator)
+}
@@ -0,0 +1,94 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ConstraintStatus fetches the Constraint and its Status
+func ConstraintStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ConstraintByID(conn, acceptLanguage, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// PortfolioShareStatus fetches the organization Portfolio Share operation and its Status
+func PortfolioShareStatus(conn *servicecatalog.ServiceCatalog, token string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.PortfolioShareStatusByToken(conn, token)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// ProductStatus fetches the Product and its Status
+func ProductStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ProductByID(conn, acceptLanguage, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ProductViewDetail.Status), nil
+	}
+}
+
+// ProvisioningArtifactStatus fetches the Provisioning Artifact and its Status
+func ProvisioningArtifactStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, productID, provisioningArtifactID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ProvisioningArtifactByTwoPartKey(conn, acceptLanguage, productID, provisioningArtifactID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// RecordStatus fetches the Record and its Status
+func RecordStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.RecordByID(conn, acceptLanguage, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RecordDetail.Status), nil
+	}
+}
@@ -0,0 +1,160 @@
+package waiter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Constraint to become available
+	ConstraintReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for an organization Portfolio Share operation to complete
+	PortfolioShareTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for a Product to become available
+	ProductReadyTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Provisioning Artifact to become available
+	ProvisioningArtifactReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for a Provisioned Product to be provisioned or updated
+	ProvisionedProductReadyTimeout = 30 * time.Minute
+
+	// Maximum amount of time to wait for a Provisioned Product to be terminated
+	ProvisionedProductTerminatedTimeout = 30 * time.Minute
+)
+
+// ConstraintReady waits for a Constraint to return AVAILABLE
+func ConstraintReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeConstraintOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ConstraintStatus(conn, acceptLanguage, id),
+		Timeout: ConstraintReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeConstraintOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// PortfolioShareCompleted waits for an organization Portfolio Share operation to return COMPLETED
+func PortfolioShareCompleted(conn *servicecatalog.ServiceCatalog, token string) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.ShareStatusNotStarted, servicecatalog.ShareStatusInProgress},
+		Target:  []string{servicecatalog.ShareStatusCompleted},
+		Refresh: PortfolioShareStatus(conn, token),
+		Timeout: PortfolioShareTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
+		return v, portfolioShareError(v, err)
+	}
+
+	return nil, err
+}
+
+// ProductReady waits for a Product to return AVAILABLE
+func ProductReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ProductStatus(conn, acceptLanguage, id),
+		Timeout: ProductReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ProvisioningArtifactReady waits for a Provisioning Artifact to return AVAILABLE
+func ProvisioningArtifactReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, productID, provisioningArtifactID string) (*servicecatalog.DescribeProvisioningArtifactOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ProvisioningArtifactStatus(conn, acceptLanguage, productID, provisioningArtifactID),
+		Timeout: ProvisioningArtifactReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// RecordSucceeded waits for a Record to return SUCCEEDED
+func RecordSucceeded(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string, timeout time.Duration) (*servicecatalog.DescribeRecordOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.RecordStatusCreated, servicecatalog.RecordStatusInProgress, servicecatalog.RecordStatusInProgressInError},
+		Target:  []string{servicecatalog.RecordStatusSucceeded},
+		Refresh: RecordStatus(conn, acceptLanguage, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeRecordOutput); ok {
+		return v, recordError(v, err)
+	}
+
+	return nil, err
+}
+
+// portfolioShareError adds the share errors reported for the organization Portfolio Share operation, if any, to err.
+func portfolioShareError(output *servicecatalog.DescribePortfolioShareStatusOutput, err error) error {
+	if err == nil || output.ShareDetails == nil || len(output.ShareDetails.ShareErrors) == 0 {
+		return err
+	}
+
+	var messages []string
+
+	for _, shareError := range output.ShareDetails.ShareErrors {
+		if shareError == nil {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s (accounts: %s)", aws.StringValue(shareError.Error), aws.StringValue(shareError.Message), strings.Join(aws.StringValueSlice(shareError.Accounts), ", ")))
+	}
+
+	return fmt.Errorf("%w: %s", err, strings.Join(messages, "; "))
+}
+
+// recordError adds the errors reported for the Record, if any, to err.
+func recordError(output *servicecatalog.DescribeRecordOutput, err error) error {
+	if err == nil || len(output.RecordDetail.RecordErrors) == 0 {
+		return err
+	}
+
+	var messages []string
+
+	for _, recordError := range output.RecordDetail.RecordErrors {
+		if recordError == nil {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(recordError.Code), aws.StringValue(recordError.Description)))
+	}
+
+	return fmt.Errorf("%w: %s", err, strings.Join(messages, "; "))
+}
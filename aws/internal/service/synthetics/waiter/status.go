@@ -0,0 +1,30 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// CanaryState fetches the Canary and its State
+func CanaryState(conn *synthetics.Synthetics, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		canary, err := finder.CanaryByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if canary.Status == nil {
+			return canary, "", nil
+		}
+
+		return canary, aws.StringValue(canary.Status.State), nil
+	}
+}
@@ -0,0 +1,114 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Canary to be created, updated, started, stopped or deleted
+	CanaryTimeout = 5 * time.Minute
+)
+
+// CanaryReady waits for a Canary to return READY
+func CanaryReady(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateCreating, synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateReady},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryError(v, err)
+	}
+
+	return nil, err
+}
+
+// CanaryUpdated waits for an updated Canary to return to a stable state
+func CanaryUpdated(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateReady, synthetics.CanaryStateRunning, synthetics.CanaryStateStopped},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryError(v, err)
+	}
+
+	return nil, err
+}
+
+// CanaryRunning waits for a Canary to return RUNNING
+func CanaryRunning(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateStarting},
+		Target:  []string{synthetics.CanaryStateRunning},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryError(v, err)
+	}
+
+	return nil, err
+}
+
+// CanaryStopped waits for a Canary to return STOPPED
+func CanaryStopped(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateStopping, synthetics.CanaryStateRunning},
+		Target:  []string{synthetics.CanaryStateStopped},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryError(v, err)
+	}
+
+	return nil, err
+}
+
+// CanaryDeleted waits for a Canary to be deleted
+func CanaryDeleted(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateDeleting},
+		Target:  []string{},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, canaryError(v, err)
+	}
+
+	return nil, err
+}
+
+// canaryError adds the reason for the Canary's state, if any, to err.
+func canaryError(canary *synthetics.Canary, err error) error {
+	if err == nil || canary.Status == nil || canary.Status.StateReason == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", err, aws.StringValue(canary.Status.StateReason))
+}
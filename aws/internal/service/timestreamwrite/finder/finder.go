@@ -0,0 +1,67 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DatabaseByName retrieves a Timestream Database by name.
+func DatabaseByName(conn *timestreamwrite.TimestreamWrite, name string) (*timestreamwrite.Database, error) {
+	input := &timestreamwrite.DescribeDatabaseInput{
+		DatabaseName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatabase(input)
+
+	if tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Database == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Database, nil
+}
+
+// TableByName retrieves a Timestream Table by database and table name.
+func TableByName(conn *timestreamwrite.TimestreamWrite, databaseName, tableName string) (*timestreamwrite.Table, error) {
+	input := &timestreamwrite.DescribeTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	output, err := conn.DescribeTable(input)
+
+	if tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Table == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Table, nil
+}
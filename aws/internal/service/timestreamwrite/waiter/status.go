@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// TableStatus fetches the Table and its Status
+func TableStatus(conn *timestreamwrite.TimestreamWrite, databaseName, tableName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		table, err := finder.TableByName(conn, databaseName, tableName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return table, aws.StringValue(table.TableStatus), nil
+	}
+}
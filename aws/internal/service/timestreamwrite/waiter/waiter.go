@@ -0,0 +1,31 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Table to be deleted
+	TableDeletedTimeout = 10 * time.Minute
+)
+
+// TableDeleted waits for a Table to be deleted
+func TableDeleted(conn *timestreamwrite.TimestreamWrite, databaseName, tableName string) (*timestreamwrite.Table, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{timestreamwrite.TableStatusActive, timestreamwrite.TableStatusDeleting},
+		Target:  []string{},
+		Refresh: TableStatus(conn, databaseName, tableName),
+		Timeout: TableDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*timestreamwrite.Table); ok {
+		return v, err
+	}
+
+	return nil, err
+}
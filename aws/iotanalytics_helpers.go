@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Channel, data store, dataset and pipeline names share the same constraints.
+func iotAnalyticsNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, 128),
+			validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+		),
+	}
+}
+
+func iotAnalyticsRetentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					ExactlyOneOf: []string{"retention_period.0.number_of_days", "retention_period.0.unlimited"},
+				},
+				"unlimited": {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					ExactlyOneOf: []string{"retention_period.0.number_of_days", "retention_period.0.unlimited"},
+				},
+			},
+		},
+	}
+}
+
+// Channels and data stores share the same storage options.
+func iotAnalyticsStorageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"customer_managed_s3": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"storage.0.customer_managed_s3", "storage.0.service_managed_s3"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(3, 255),
+							},
+							"key_prefix": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 255),
+									validation.StringMatch(regexp.MustCompile(`/$`), "must end with a forward slash (/)"),
+								),
+							},
+							"role_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+				"service_managed_s3": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"storage.0.customer_managed_s3", "storage.0.service_managed_s3"},
+					Elem: &schema.Resource{
+						// No options currently; just existence of "service_managed_s3".
+						Schema: map[string]*schema.Schema{},
+					},
+				},
+			},
+		},
+	}
+}
+
+// iotAnalyticsStorageTypeChanged reports whether the choice between service-managed and
+// customer-managed S3 storage changed, which cannot be done in place.
+func iotAnalyticsStorageTypeChanged(_ context.Context, old, new, meta interface{}) bool {
+	return iotAnalyticsCustomerManagedS3Configured(old) != iotAnalyticsCustomerManagedS3Configured(new)
+}
+
+func iotAnalyticsCustomerManagedS3Configured(v interface{}) bool {
+	tfList, ok := v.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return false
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return false
+	}
+
+	tfList, ok = tfMap["customer_managed_s3"].([]interface{})
+
+	return ok && len(tfList) > 0
+}
+
+func expandIotAnalyticsRetentionPeriod(tfMap map[string]interface{}) *iotanalytics.RetentionPeriod {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v != 0 {
+		apiObject.NumberOfDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsRetentionPeriod(apiObject *iotanalytics.RetentionPeriod) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unlimited": aws.BoolValue(apiObject.Unlimited),
+	}
+
+	if v := apiObject.NumberOfDays; v != nil {
+		tfMap["number_of_days"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -1,11 +1,20 @@
 package aws
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/creds"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
 )
 
 // Provider returns a *schema.Provider.
@@ -39,6 +48,93 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"credential_process", "sso"},
+				Description:   "Configuration block to assume an IAM Role with an OpenID Connect (OIDC) token.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(900, 43200),
+							Description:  "Seconds to restrict the assume role session duration.",
+						},
+						"policy_arns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+						},
+						"role_arn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Amazon Resource Name of the IAM Role to assume.",
+						},
+						"session_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Identifier for the assumed role session.",
+						},
+						"web_identity_token": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+							Description:  "OpenID Connect (OIDC) token issued by the identity provider.",
+						},
+						"web_identity_token_file": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+							Description:  "Path of a file containing the OpenID Connect (OIDC) token issued by the identity provider.",
+						},
+					},
+				},
+			},
+
+			"credential_process": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"assume_role_with_web_identity", "sso"},
+				Description:   "Command to run to retrieve credentials, in the format of the shared config credential_process setting.",
+			},
+
+			"sso": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"assume_role_with_web_identity", "credential_process"},
+				Description:   "Configuration block to retrieve credentials for an AWS SSO permission set, using the access token cached by `aws sso login`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAwsAccountId,
+							Description:  "AWS account ID to retrieve credentials for.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the AWS SSO instance. Defaults to the provider region.",
+						},
+						"role_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the permission set to retrieve credentials for.",
+						},
+						"start_url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "AWS SSO user portal URL.",
+						},
+					},
+				},
+			},
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -87,6 +183,30 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_API_TRACE_FILE", ""),
+				Description: "Path of a file to append a JSON-lines trace of every AWS API request to.",
+			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to default resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to default across all resources.",
+						},
+					},
+				},
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -121,6 +241,143 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: "Path of a file containing PEM encoded certificate authorities to trust in addition to the system ones.",
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_EC2_METADATA_SERVICE_ENDPOINT", ""),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Address of the EC2 metadata service endpoint to use.",
+			},
+
+			"http_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Address of an HTTP proxy to use when accessing the AWS API.",
+			},
+
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", RetryModeStandard),
+				ValidateFunc: validation.StringInSlice([]string{RetryModeAdaptive, RetryModeStandard}, false),
+				Description:  "How retries are attempted. `adaptive` additionally rate limits requests to services throttling requests.",
+			},
+
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to limit the rate of AWS API requests per service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"adaptive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether to reduce the request rate for a service when it throttles requests.",
+						},
+						"service": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Per-service request rate limits.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"burst": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "Maximum number of requests sent at once before the rate limit applies.",
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										Description:  "Service identifier, e.g. ec2, iam or route53.",
+									},
+									"requests_per_second": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatAtLeast(0.1),
+										Description:  "Average number of requests per second sent to the service.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"read_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to cache the responses of selected read-only AWS API calls shared by many resources during a run.",
+			},
+
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to enforce resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      keyvaluetags.RequiredEnforcementError,
+							ValidateFunc: validation.StringInSlice([]string{keyvaluetags.RequiredEnforcementError, keyvaluetags.RequiredEnforcementWarn}, false),
+							Description:  "Whether a resource missing required tags fails the plan (error) or only logs a warning (warn).",
+						},
+						"exempt_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types which are not checked for required tags.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Resource tag keys required across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Values allowed for the required tag key.",
+									},
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										Description:  "Required tag key.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression the required tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -162,6 +419,20 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -332,6 +603,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":                   dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                                     dataSourceAwsRegion(),
 			"aws_regions":                                    dataSourceAwsRegions(),
+			"aws_resourcegroupstaggingapi_resources":         dataSourceAwsResourceGroupsTaggingAPIResources(),
 			"aws_route":                                      dataSourceAwsRoute(),
 			"aws_route_table":                                dataSourceAwsRouteTable(),
 			"aws_route_tables":                               dataSourceAwsRouteTables(),
@@ -363,6 +635,8 @@ func Provider() *schema.Provider {
 			"aws_storagegateway_local_disk":                  dataSourceAwsStorageGatewayLocalDisk(),
 			"aws_subnet":                                     dataSourceAwsSubnet(),
 			"aws_subnet_ids":                                 dataSourceAwsSubnetIDs(),
+			"aws_timestreamwrite_database":                   dataSourceAwsTimestreamWriteDatabase(),
+			"aws_timestreamwrite_table":                      dataSourceAwsTimestreamWriteTable(),
 			"aws_transfer_server":                            dataSourceAwsTransferServer(),
 			"aws_vpcs":                                       dataSourceAwsVpcs(),
 			"aws_security_group":                             dataSourceAwsSecurityGroup(),
@@ -409,6 +683,11 @@ func Provider() *schema.Provider {
 			"aws_ami_copy":                                            resourceAwsAmiCopy(),
 			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
 			"aws_ami_launch_permission":                               resourceAwsAmiLaunchPermission(),
+			"aws_amplify_app":                                         resourceAwsAmplifyApp(),
+			"aws_amplify_backend_environment":                         resourceAwsAmplifyBackendEnvironment(),
+			"aws_amplify_branch":                                      resourceAwsAmplifyBranch(),
+			"aws_amplify_domain_association":                          resourceAwsAmplifyDomainAssociation(),
+			"aws_amplify_webhook":                                     resourceAwsAmplifyWebhook(),
 			"aws_api_gateway_account":                                 resourceAwsApiGatewayAccount(),
 			"aws_api_gateway_api_key":                                 resourceAwsApiGatewayApiKey(),
 			"aws_api_gateway_authorizer":                              resourceAwsApiGatewayAuthorizer(),
@@ -456,6 +735,10 @@ func Provider() *schema.Provider {
 			"aws_appmesh_virtual_node":                                resourceAwsAppmeshVirtualNode(),
 			"aws_appmesh_virtual_router":                              resourceAwsAppmeshVirtualRouter(),
 			"aws_appmesh_virtual_service":                             resourceAwsAppmeshVirtualService(),
+			"aws_appstream_fleet":                                     resourceAwsAppStreamFleet(),
+			"aws_appstream_fleet_stack_association":                   resourceAwsAppStreamFleetStackAssociation(),
+			"aws_appstream_image_builder":                             resourceAwsAppStreamImageBuilder(),
+			"aws_appstream_stack":                                     resourceAwsAppStreamStack(),
 			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
 			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
 			"aws_appsync_function":                                    resourceAwsAppsyncFunction(),
@@ -486,6 +769,8 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_distribution":                             resourceAwsCloudFrontDistribution(),
 			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
 			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
+			"aws_cloudsearch_domain":                                  resourceAwsCloudSearchDomain(),
+			"aws_cloudsearch_domain_service_access_policy":            resourceAwsCloudSearchDomainServiceAccessPolicy(),
 			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
 			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
 			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
@@ -507,6 +792,8 @@ func Provider() *schema.Provider {
 			"aws_config_organization_custom_rule":                     resourceAwsConfigOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":                    resourceAwsConfigOrganizationManagedRule(),
 			"aws_config_remediation_configuration":                    resourceAwsConfigRemediationConfiguration(),
+			"aws_connect_contact_flow":                                resourceAwsConnectContactFlow(),
+			"aws_connect_instance":                                    resourceAwsConnectInstance(),
 			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
 			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
@@ -630,6 +917,8 @@ func Provider() *schema.Provider {
 			"aws_ecr_lifecycle_policy":                                resourceAwsEcrLifecyclePolicy(),
 			"aws_ecr_repository":                                      resourceAwsEcrRepository(),
 			"aws_ecr_repository_policy":                               resourceAwsEcrRepositoryPolicy(),
+			"aws_ecrpublic_repository":                                resourceAwsEcrPublicRepository(),
+			"aws_ecrpublic_repository_policy":                         resourceAwsEcrPublicRepositoryPolicy(),
 			"aws_ecs_capacity_provider":                               resourceAwsEcsCapacityProvider(),
 			"aws_ecs_cluster":                                         resourceAwsEcsCluster(),
 			"aws_ecs_service":                                         resourceAwsEcsService(),
@@ -745,6 +1034,12 @@ func Provider() *schema.Provider {
 			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
 			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
 			"aws_iot_role_alias":                                      resourceAwsIotRoleAlias(),
+			"aws_iotanalytics_channel":                                resourceAwsIotAnalyticsChannel(),
+			"aws_iotanalytics_dataset":                                resourceAwsIotAnalyticsDataset(),
+			"aws_iotanalytics_datastore":                              resourceAwsIotAnalyticsDatastore(),
+			"aws_iotanalytics_pipeline":                               resourceAwsIotAnalyticsPipeline(),
+			"aws_iotevents_detector_model":                            resourceAwsIotEventsDetectorModel(),
+			"aws_iotevents_input":                                     resourceAwsIotEventsInput(),
 			"aws_key_pair":                                            resourceAwsKeyPair(),
 			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
 			"aws_kinesisanalyticsv2_application":                      resourceAwsKinesisAnalyticsV2Application(),
@@ -787,6 +1082,12 @@ func Provider() *schema.Provider {
 			"aws_lb_ssl_negotiation_policy":                           resourceAwsLBSSLNegotiationPolicy(),
 			"aws_macie_member_account_association":                    resourceAwsMacieMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":                         resourceAwsMacieS3BucketAssociation(),
+			"aws_macie2_account":                                      resourceAwsMacie2Account(),
+			"aws_macie2_classification_job":                           resourceAwsMacie2ClassificationJob(),
+			"aws_macie2_custom_data_identifier":                       resourceAwsMacie2CustomDataIdentifier(),
+			"aws_macie2_findings_filter":                              resourceAwsMacie2FindingsFilter(),
+			"aws_macie2_member":                                       resourceAwsMacie2Member(),
+			"aws_macie2_organization_admin_account":                   resourceAwsMacie2OrganizationAdminAccount(),
 			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
 			"aws_mq_broker":                                           resourceAwsMqBroker(),
 			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
@@ -797,6 +1098,7 @@ func Provider() *schema.Provider {
 			"aws_msk_cluster":                                         resourceAwsMskCluster(),
 			"aws_msk_configuration":                                   resourceAwsMskConfiguration(),
 			"aws_msk_scram_secret_association":                        resourceAwsMskScramSecretAssociation(),
+			"aws_mwaa_environment":                                    resourceAwsMwaaEnvironment(),
 			"aws_nat_gateway":                                         resourceAwsNatGateway(),
 			"aws_network_acl":                                         resourceAwsNetworkAcl(),
 			"aws_default_network_acl":                                 resourceAwsDefaultNetworkAcl(),
@@ -815,6 +1117,13 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_logging_configuration":               resourceAwsNetworkFirewallLoggingConfiguration(),
 			"aws_networkfirewall_resource_policy":                     resourceAwsNetworkFirewallResourcePolicy(),
 			"aws_networkfirewall_rule_group":                          resourceAwsNetworkFirewallRuleGroup(),
+			"aws_networkmanager_customer_gateway_association":         resourceAwsNetworkManagerCustomerGatewayAssociation(),
+			"aws_networkmanager_device":                               resourceAwsNetworkManagerDevice(),
+			"aws_networkmanager_global_network":                       resourceAwsNetworkManagerGlobalNetwork(),
+			"aws_networkmanager_link":                                 resourceAwsNetworkManagerLink(),
+			"aws_networkmanager_link_association":                     resourceAwsNetworkManagerLinkAssociation(),
+			"aws_networkmanager_site":                                 resourceAwsNetworkManagerSite(),
+			"aws_networkmanager_transit_gateway_registration":         resourceAwsNetworkManagerTransitGatewayRegistration(),
 			"aws_opsworks_application":                                resourceAwsOpsworksApplication(),
 			"aws_opsworks_stack":                                      resourceAwsOpsworksStack(),
 			"aws_opsworks_java_app_layer":                             resourceAwsOpsworksJavaAppLayer(),
@@ -872,6 +1181,7 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_query_log_config_association":       resourceAwsRoute53ResolverQueryLogConfigAssociation(),
 			"aws_route53_resolver_rule_association":                   resourceAwsRoute53ResolverRuleAssociation(),
 			"aws_route53_resolver_rule":                               resourceAwsRoute53ResolverRule(),
+			"aws_route53domains_registered_domain":                    resourceAwsRoute53DomainsRegisteredDomain(),
 			"aws_route":                                               resourceAwsRoute(),
 			"aws_route_table":                                         resourceAwsRouteTable(),
 			"aws_default_route_table":                                 resourceAwsDefaultRouteTable(),
@@ -929,7 +1239,14 @@ func Provider() *schema.Provider {
 			"aws_securityhub_member":                                  resourceAwsSecurityHubMember(),
 			"aws_securityhub_product_subscription":                    resourceAwsSecurityHubProductSubscription(),
 			"aws_securityhub_standards_subscription":                  resourceAwsSecurityHubStandardsSubscription(),
+			"aws_servicecatalog_constraint":                           resourceAwsServiceCatalogConstraint(),
 			"aws_servicecatalog_portfolio":                            resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_portfolio_share":                      resourceAwsServiceCatalogPortfolioShare(),
+			"aws_servicecatalog_principal_portfolio_association":      resourceAwsServiceCatalogPrincipalPortfolioAssociation(),
+			"aws_servicecatalog_product":                              resourceAwsServiceCatalogProduct(),
+			"aws_servicecatalog_product_portfolio_association":        resourceAwsServiceCatalogProductPortfolioAssociation(),
+			"aws_servicecatalog_provisioned_product":                  resourceAwsServiceCatalogProvisionedProduct(),
+			"aws_servicecatalog_provisioning_artifact":                resourceAwsServiceCatalogProvisioningArtifact(),
 			"aws_service_discovery_http_namespace":                    resourceAwsServiceDiscoveryHttpNamespace(),
 			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
@@ -979,6 +1296,9 @@ func Provider() *schema.Provider {
 			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
 			"aws_subnet":                                              resourceAwsSubnet(),
 			"aws_swf_domain":                                          resourceAwsSwfDomain(),
+			"aws_synthetics_canary":                                   resourceAwsSyntheticsCanary(),
+			"aws_timestreamwrite_database":                            resourceAwsTimestreamWriteDatabase(),
+			"aws_timestreamwrite_table":                               resourceAwsTimestreamWriteTable(),
 			"aws_transfer_server":                                     resourceAwsTransferServer(),
 			"aws_transfer_ssh_key":                                    resourceAwsTransferSshKey(),
 			"aws_transfer_user":                                       resourceAwsTransferUser(),
@@ -1081,6 +1401,11 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	providerAddDefaultTagsWarning(provider.ResourcesMap)
+	providerAddRequiredTagsCustomizeDiff(provider.ResourcesMap)
+	providerAddApiTraceContext(provider.DataSourcesMap)
+	providerAddApiTraceContext(provider.ResourcesMap)
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1145,6 +1470,10 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability.",
 	}
 
 	endpointServiceNames = []string{
@@ -1301,89 +1630,84 @@ func init() {
 	}
 }
 
+// LogThrottleSummary logs the number of AWS API requests throttled per service
+// since the provider started. It is intended to be called when the provider
+// shuts down.
+func LogThrottleSummary() {
+	ratelimit.LogThrottleSummary()
+}
+
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		terraformVersion:        terraformVersion,
+		AccessKey:                  d.Get("access_key").(string),
+		ApiTraceFile:               d.Get("api_trace_file").(string),
+		CredentialProcess:          d.Get("credential_process").(string),
+		CustomCABundle:             d.Get("custom_ca_bundle").(string),
+		SecretKey:                  d.Get("secret_key").(string),
+		Profile:                    d.Get("profile").(string),
+		Token:                      d.Get("token").(string),
+		Region:                     d.Get("region").(string),
+		CredsFilename:              d.Get("shared_credentials_file").(string),
+		DefaultTagsConfig:          expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		EC2MetadataServiceEndpoint: d.Get("ec2_metadata_service_endpoint").(string),
+		Endpoints:                  make(map[string]string),
+		HTTPProxy:                  d.Get("http_proxy").(string),
+		MaxRetries:                 d.Get("max_retries").(int),
+		IgnoreTagsConfig:           expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		Insecure:                   d.Get("insecure").(bool),
+		RateLimitsConfig:           expandProviderRateLimits(d.Get("rate_limits").([]interface{})),
+		ReadCache:                  d.Get("read_cache").(bool),
+		RequiredTagsConfig:         expandProviderRequiredTags(d.Get("required_tags").([]interface{})),
+		RetryMode:                  d.Get("retry_mode").(string),
+		SkipCredsValidation:        d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:        d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:       d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:    d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:       d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:           d.Get("s3_force_path_style").(bool),
+		UseDualStackEndpoint:       d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:            d.Get("use_fips_endpoint").(bool),
+		terraformVersion:           terraformVersion,
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
-
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
-
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
+		assumeRole := expandProviderAssumeRole(l[0].(map[string]interface{}))
 
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
+		config.AssumeRoleARN = assumeRole.RoleARN
+		config.AssumeRoleDurationSeconds = assumeRole.DurationSeconds
+		config.AssumeRoleExternalID = assumeRole.ExternalID
+		config.AssumeRolePolicy = assumeRole.Policy
+		config.AssumeRolePolicyARNs = assumeRole.PolicyARNs
+		config.AssumeRoleSessionName = assumeRole.SessionName
+		config.AssumeRoleTags = assumeRole.Tags
+		config.AssumeRoleTransitiveTagKeys = assumeRole.TransitiveTagKeys
 
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 
-				if !ok {
-					continue
-				}
+		// Subsequent roles are assumed in order with the credentials of the previous role
+		for _, tfMapRaw := range l[1:] {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
+			if !ok {
+				continue
 			}
-		}
-
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
-		}
-
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
-		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
+			assumeRole := expandProviderAssumeRole(tfMap)
 
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
+			log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
 
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTags[k] = v
-			}
+			config.AssumeRoleChain = append(config.AssumeRoleChain, assumeRole)
 		}
+	}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.WebIdentity = expandProviderAssumeRoleWithWebIdentity(l[0].(map[string]interface{}))
 
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
-		}
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.WebIdentity.RoleARN, config.WebIdentity.SessionName)
+	}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+	if l, ok := d.Get("sso").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.SSO = expandProviderSSO(l[0].(map[string]interface{}))
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1415,9 +1739,9 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks of IAM Roles to assume, in order, each with the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1468,6 +1792,130 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func expandProviderAssumeRole(m map[string]interface{}) *creds.AssumeRole {
+	assumeRole := &creds.AssumeRole{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := m["external_id"].(string); ok && v != "" {
+		assumeRole.ExternalID = v
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+		assumeRole.Tags = make(map[string]string)
+
+		for k, vRaw := range tagMapRaw {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.Tags[k] = v
+		}
+	}
+
+	if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+		for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+			transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, transitiveTagKey)
+		}
+	}
+
+	return assumeRole
+}
+
+func expandProviderAssumeRoleWithWebIdentity(m map[string]interface{}) *creds.WebIdentity {
+	webIdentity := &creds.WebIdentity{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		webIdentity.DurationSeconds = v
+	}
+
+	if v, ok := m["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, policyARNRaw := range v.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			webIdentity.PolicyARNs = append(webIdentity.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		webIdentity.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		webIdentity.SessionName = v
+	}
+
+	if v, ok := m["web_identity_token"].(string); ok && v != "" {
+		webIdentity.Token = v
+	}
+
+	if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+		webIdentity.TokenFile = v
+	}
+
+	return webIdentity
+}
+
+func expandProviderSSO(m map[string]interface{}) *creds.SSO {
+	sso := &creds.SSO{}
+
+	if v, ok := m["account_id"].(string); ok && v != "" {
+		sso.AccountID = v
+	}
+
+	if v, ok := m["region"].(string); ok && v != "" {
+		sso.Region = v
+	}
+
+	if v, ok := m["role_name"].(string); ok && v != "" {
+		sso.RoleName = v
+	}
+
+	if v, ok := m["start_url"].(string); ok && v != "" {
+		sso.StartURL = v
+	}
+
+	return sso
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1489,6 +1937,212 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &keyvaluetags.DefaultConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		defaultConfig.Tags = keyvaluetags.New(v)
+	}
+
+	return defaultConfig
+}
+
+func expandProviderRateLimits(l []interface{}) *ratelimit.Config {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	rateLimitConfig := &ratelimit.Config{
+		Services: make(map[string]*ratelimit.ServiceConfig),
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["adaptive"].(bool); ok {
+		rateLimitConfig.Adaptive = v
+	}
+
+	if v, ok := m["service"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rateLimitConfig.Services[tfMap["name"].(string)] = &ratelimit.ServiceConfig{
+				Burst:             tfMap["burst"].(int),
+				RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			}
+		}
+	}
+
+	return rateLimitConfig
+}
+
+func expandProviderRequiredTags(l []interface{}) *keyvaluetags.RequiredConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	requiredConfig := &keyvaluetags.RequiredConfig{
+		ExemptResourceTypes: make(map[string]struct{}),
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["enforcement"].(string); ok {
+		requiredConfig.Enforcement = v
+	}
+
+	if v, ok := m["exempt_resource_types"].(*schema.Set); ok {
+		for _, resourceType := range v.List() {
+			requiredConfig.ExemptResourceTypes[resourceType.(string)] = struct{}{}
+		}
+	}
+
+	if v, ok := m["tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			requiredTag := &keyvaluetags.RequiredTag{
+				Key: tfMap["key"].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				for _, allowedValue := range v.List() {
+					requiredTag.AllowedValues = append(requiredTag.AllowedValues, allowedValue.(string))
+				}
+			}
+
+			if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+				// Validated by the schema.
+				requiredTag.ValueRegex = regexp.MustCompile(v)
+			}
+
+			requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+		}
+	}
+
+	return requiredConfig
+}
+
+// providerAddDefaultTagsWarning adds a warning to the creation and update of
+// every resource with a configurable tags map attribute but no tags_all
+// attribute, as those resources do not apply the provider default_tags.
+func providerAddDefaultTagsWarning(resources map[string]*schema.Resource) {
+	for resourceType, r := range resources {
+		if v, ok := r.Schema["tags"]; !ok || v.Type != schema.TypeMap || !v.Optional {
+			continue
+		}
+
+		if _, ok := r.Schema["tags_all"]; ok {
+			continue
+		}
+
+		resourceUseContextCRUD(r)
+
+		r.CreateContext = defaultTagsWarningFunc(resourceType, r.CreateContext)
+		r.UpdateContext = defaultTagsWarningFunc(resourceType, r.UpdateContext)
+	}
+}
+
+func defaultTagsWarningFunc(resourceType string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+
+		if defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig; defaultTagsConfig == nil || len(defaultTagsConfig.Tags) == 0 {
+			return diags
+		}
+
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s does not support provider default_tags", resourceType),
+			Detail:   "The provider default_tags are not applied to this resource. Configure the tags with the resource tags argument instead.",
+		})
+	}
+}
+
+// resourceUseContextCRUD replaces the legacy CRUD functions of the resource
+// with context-aware equivalents calling them, so that wrappers only need to
+// handle the context-aware functions.
+func resourceUseContextCRUD(r *schema.Resource) {
+	if r.Create != nil {
+		r.CreateContext = legacyCRUDContextFunc(r.Create)
+		r.Create = nil
+	}
+
+	if r.Read != nil {
+		r.ReadContext = legacyCRUDContextFunc(r.Read)
+		r.Read = nil
+	}
+
+	if r.Update != nil {
+		r.UpdateContext = legacyCRUDContextFunc(r.Update)
+		r.Update = nil
+	}
+
+	if r.Delete != nil {
+		r.DeleteContext = legacyCRUDContextFunc(r.Delete)
+		r.Delete = nil
+	}
+}
+
+func legacyCRUDContextFunc(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(f(d, meta))
+	}
+}
+
+// providerAddRequiredTagsCustomizeDiff adds the plan-time required_tags check
+// to every resource with a configurable tags map attribute.
+func providerAddRequiredTagsCustomizeDiff(resources map[string]*schema.Resource) {
+	for resourceType, r := range resources {
+		if v, ok := r.Schema["tags"]; !ok || v.Type != schema.TypeMap || !v.Optional {
+			continue
+		}
+
+		if r.CustomizeDiff == nil {
+			r.CustomizeDiff = requiredTagsCustomizeDiff(resourceType)
+		} else {
+			r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, requiredTagsCustomizeDiff(resourceType))
+		}
+	}
+}
+
+// providerAddApiTraceContext adds the resource type and ID to the context
+// passed to context-aware CRUD functions, so that the API trace can attribute
+// requests made with that context to the resource.
+func providerAddApiTraceContext(resources map[string]*schema.Resource) {
+	for resourceType, r := range resources {
+		r.CreateContext = apiTraceContextFunc(resourceType, r.CreateContext)
+		r.ReadContext = apiTraceContextFunc(resourceType, r.ReadContext)
+		r.UpdateContext = apiTraceContextFunc(resourceType, r.UpdateContext)
+		r.DeleteContext = apiTraceContextFunc(resourceType, r.DeleteContext)
+	}
+}
+
+func apiTraceContextFunc(resourceType string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(apitrace.WithResource(ctx, resourceType, d.Id()), d, meta)
+	}
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 const (
@@ -179,6 +180,83 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderAddDefaultTagsWarning(t *testing.T) {
+	noop := func(*schema.ResourceData, interface{}) error { return nil }
+	resources := map[string]*schema.Resource{
+		"test_supported": {
+			Schema: map[string]*schema.Schema{
+				"tags":     tagsSchema(),
+				"tags_all": tagsSchemaTrulyComputed(),
+			},
+			Create: noop,
+			Read:   noop,
+			Update: noop,
+			Delete: noop,
+		},
+		"test_unsupported": {
+			Schema: map[string]*schema.Schema{
+				"tags": tagsSchema(),
+			},
+			Create: noop,
+			Read:   noop,
+			Update: noop,
+			Delete: noop,
+		},
+	}
+
+	providerAddDefaultTagsWarning(resources)
+
+	if r := resources["test_supported"]; r.Create == nil || r.CreateContext != nil {
+		t.Error("supported resource: expected legacy CRUD functions to be kept")
+	}
+
+	r := resources["test_unsupported"]
+
+	if r.Create != nil || r.Read != nil || r.Update != nil || r.Delete != nil {
+		t.Fatal("unsupported resource: expected legacy CRUD functions to be replaced")
+	}
+
+	testCases := []struct {
+		name         string
+		defaultTags  *keyvaluetags.DefaultConfig
+		wantWarnings int
+	}{
+		{
+			name: "no default tags",
+		},
+		{
+			name:        "empty default tags",
+			defaultTags: &keyvaluetags.DefaultConfig{},
+		},
+		{
+			name: "default tags",
+			defaultTags: &keyvaluetags.DefaultConfig{
+				Tags: keyvaluetags.New(map[string]string{"Owner": "Ops"}),
+			},
+			wantWarnings: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			meta := &AWSClient{DefaultTagsConfig: testCase.defaultTags}
+
+			for name, f := range map[string]func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics{
+				"create": r.CreateContext,
+				"update": r.UpdateContext,
+			} {
+				if got := len(f(context.Background(), r.TestResourceData(), meta)); got != testCase.wantWarnings {
+					t.Errorf("%s: got %d diagnostics; want %d", name, got, testCase.wantWarnings)
+				}
+			}
+
+			if got := len(r.ReadContext(context.Background(), r.TestResourceData(), meta)); got != 0 {
+				t.Errorf("read: got %d diagnostics; want 0", got)
+			}
+		})
+	}
+}
+
 // testAccPreCheck verifies and sets required provider testing configuration
 //
 // This PreCheck function should be present in every acceptance test. It allows
@@ -868,6 +946,33 @@ func testAccMultipleRegionProviderConfig(regions int) string {
 	return config.String()
 }
 
+func testAccProviderConfigDefaultTags_Tags1(tag1, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+}
+`, tag1, value1)
+}
+
+func testAccProviderConfigDefaultTags_Tags2(tag1, value1, tag2, value2 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+      %[3]q = %[4]q
+    }
+  }
+}
+`, tag1, value1, tag2, value2)
+}
+
 func testAccProviderConfigIgnoreTagsKeyPrefixes1(keyPrefix1 string) string {
 	//lintignore:AT004
 	return fmt.Sprintf(`
@@ -1119,6 +1224,41 @@ func TestAccAWSProvider_Endpoints(t *testing.T) {
 	})
 }
 
+func TestAccAWSProvider_RequiredTags_Error(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSProviderConfigRequiredTags("error", "value1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`aws_vpc does not satisfy provider required_tags`),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_RequiredTags_Warn(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigRequiredTags("warn", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_vpc.test", "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSProvider_IgnoreTags_EmptyConfigurationBlock(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -1642,6 +1782,30 @@ data "aws_arn" "test" {
 `, endpoints)
 }
 
+func testAccAWSProviderConfigRequiredTags(enforcement, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  required_tags {
+    enforcement = %[1]q
+
+    tag {
+      key            = "key1"
+      allowed_values = ["allowed1", "allowed2"]
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    key1 = %[2]q
+  }
+}
+`, enforcement, value1)
+}
+
 func testAccAWSProviderConfigIgnoreTagsEmptyConfigurationBlock() string {
 	//lintignore:AT004
 	return `
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalResourceIDSeparator separates the resource identifier from the
+// region in the ID of resources created outside of the provider region.
+const regionalResourceIDSeparator = "@"
+
+// regionalClients holds the AWSClient of each region used by resources, shared
+// by the provider client and every regional client built from it.
+type regionalClients struct {
+	sync.Mutex
+	byRegion map[string]*AWSClient
+}
+
+// RegionalClient returns the AWSClient for the given region. The service
+// clients for a region other than the provider region are created from the
+// provider session on first use and reused afterwards. An empty region
+// returns the client itself.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil || client.session == nil {
+		return nil, fmt.Errorf("region (%s) differs from the provider region (%s) and no provider session is available", region, client.region)
+	}
+
+	client.regionalClients.Lock()
+	defer client.regionalClients.Unlock()
+
+	if regionalClient, ok := client.regionalClients.byRegion[region]; ok {
+		return regionalClient, nil
+	}
+
+	if !client.config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	config := *client.config
+	config.Region = region
+
+	regionalClient := config.newAWSClient(client.session.Copy(&aws.Config{Region: aws.String(region)}), client.accountid, client.partition)
+	// The read cache is keyed without the region
+	regionalClient.readCache = nil
+	regionalClient.regionalClients = client.regionalClients
+	regionalClient.session = client.session
+	regionalClient.supportedplatforms = client.supportedplatforms
+
+	client.regionalClients.byRegion[region] = regionalClient
+
+	return regionalClient, nil
+}
+
+// regionSchema returns the schema to use for the region argument of
+// resources that can be managed outside of the provider region.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+}
+
+// dataSourceRegionSchema returns the schema to use for the region argument of
+// data sources that can read from outside of the provider region.
+func dataSourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+}
+
+// regionalClient returns the AWSClient for the region argument of the
+// resource, falling back to the provider region when it is not set.
+func regionalClient(d *schema.ResourceData, meta interface{}) (*AWSClient, error) {
+	return meta.(*AWSClient).RegionalClient(d.Get("region").(string))
+}
+
+// regionalResourceID returns the resource ID for an identifier, suffixed with
+// the region when it differs from the provider region.
+func regionalResourceID(id, region string, meta interface{}) string {
+	if region == "" || region == meta.(*AWSClient).region {
+		return id
+	}
+
+	return id + regionalResourceIDSeparator + region
+}
+
+// parseRegionalResourceID returns the identifier and region of a resource ID
+// created by regionalResourceID. The region is empty for resources in the
+// provider region.
+func parseRegionalResourceID(id string) (string, string) {
+	i := strings.LastIndex(id, regionalResourceIDSeparator)
+
+	if i < 0 {
+		return id, ""
+	}
+
+	return id[:i], id[i+len(regionalResourceIDSeparator):]
+}
+
+// resourceRegionalImportState sets the region argument from the imported
+// resource ID, e.g. my-resource@us-west-2, for resources using
+// regionalResourceID.
+func resourceRegionalImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_, region := parseRegionalResourceID(d.Id())
+
+	if region == "" {
+		region = meta.(*AWSClient).region
+	}
+
+	d.Set("region", region)
+
+	return []*schema.ResourceData{d}, nil
+}
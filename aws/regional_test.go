@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestParseRegionalResourceID(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:           "no region",
+			ID:             "my-log-group",
+			ExpectedID:     "my-log-group",
+			ExpectedRegion: "",
+		},
+		{
+			Name:           "region",
+			ID:             "my-log-group@us-west-2",
+			ExpectedID:     "my-log-group",
+			ExpectedRegion: "us-west-2",
+		},
+		{
+			Name:           "identifier with path",
+			ID:             "/aws/lambda/my-function@eu-west-1",
+			ExpectedID:     "/aws/lambda/my-function",
+			ExpectedRegion: "eu-west-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			id, region := parseRegionalResourceID(testCase.ID)
+
+			if id != testCase.ExpectedID {
+				t.Errorf("got ID %q, expected %q", id, testCase.ExpectedID)
+			}
+
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got region %q, expected %q", region, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestRegionalResourceID(t *testing.T) {
+	meta := &AWSClient{region: "us-east-1"}
+
+	testCases := []struct {
+		Name     string
+		Region   string
+		Expected string
+	}{
+		{
+			Name:     "empty region",
+			Region:   "",
+			Expected: "my-log-group",
+		},
+		{
+			Name:     "provider region",
+			Region:   "us-east-1",
+			Expected: "my-log-group",
+		},
+		{
+			Name:     "other region",
+			Region:   "us-west-2",
+			Expected: "my-log-group@us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := regionalResourceID("my-log-group", testCase.Region, meta)
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,666 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamFleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamFleetCreate,
+		Read:   resourceAwsAppStreamFleetRead,
+		Update: resourceAwsAppStreamFleetUpdate,
+		Delete: resourceAwsAppStreamFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.FleetRunningTimeout),
+			Update: schema.DefaultTimeout(waiter.FleetRunningTimeout),
+			Delete: schema.DefaultTimeout(waiter.FleetStoppedTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_capacity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"desired_instances": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"in_use": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"running": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"disconnect_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 360000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"domain_join_info": appStreamDomainJoinInfoSchema(),
+			"enable_default_internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"fleet_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appstream.FleetType_Values(), false),
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"idle_disconnect_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"image_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_arn", "image_name"},
+				ValidateFunc: validateArn,
+			},
+			"image_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_arn", "image_name"},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"max_user_duration_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(600, 360000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stream_view": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(appstream.StreamView_Values(), false),
+			},
+			"tags":       tagsSchema(),
+			"tags_all":   tagsSchemaTrulyComputed(),
+			"vpc_config": appStreamVpcConfigSchema(),
+		},
+	}
+}
+
+func appStreamDomainJoinInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"directory_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"organizational_unit_distinguished_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 2000),
+				},
+			},
+		},
+	}
+}
+
+func appStreamVpcConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"security_group_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MaxItems: 5,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"subnet_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamFleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appstream.CreateFleetInput{
+		InstanceType: aws.String(d.Get("instance_type").(string)),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("compute_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ComputeCapacity = expandAppStreamComputeCapacity(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disconnect_timeout_in_seconds"); ok {
+		input.DisconnectTimeoutInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_join_info"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DomainJoinInfo = expandAppStreamDomainJoinInfo(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("enable_default_internet_access"); ok {
+		input.EnableDefaultInternetAccess = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("fleet_type"); ok {
+		input.FleetType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.IamRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idle_disconnect_timeout_in_seconds"); ok {
+		input.IdleDisconnectTimeoutInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("image_arn"); ok {
+		input.ImageArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_name"); ok {
+		input.ImageName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_user_duration_in_seconds"); ok {
+		input.MaxUserDurationInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("stream_view"); ok {
+		input.StreamView = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConfig = expandAppStreamVpcConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AppstreamTags()
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Fleet: %s", input)
+	_, err := conn.CreateFleet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream Fleet (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := startAppStreamFleet(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsAppStreamFleetRead(d, meta)
+}
+
+func resourceAwsAppStreamFleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	fleet, err := finder.FleetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Fleet (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(fleet.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("compute_capacity", flattenAppStreamComputeCapacityStatus(fleet.ComputeCapacityStatus)); err != nil {
+		return fmt.Errorf("error setting compute_capacity: %w", err)
+	}
+	if fleet.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(fleet.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", fleet.Description)
+	d.Set("disconnect_timeout_in_seconds", fleet.DisconnectTimeoutInSeconds)
+	d.Set("display_name", fleet.DisplayName)
+	if err := d.Set("domain_join_info", flattenAppStreamDomainJoinInfo(fleet.DomainJoinInfo)); err != nil {
+		return fmt.Errorf("error setting domain_join_info: %w", err)
+	}
+	d.Set("enable_default_internet_access", fleet.EnableDefaultInternetAccess)
+	d.Set("fleet_type", fleet.FleetType)
+	d.Set("iam_role_arn", fleet.IamRoleArn)
+	d.Set("idle_disconnect_timeout_in_seconds", fleet.IdleDisconnectTimeoutInSeconds)
+	d.Set("image_arn", fleet.ImageArn)
+	d.Set("image_name", fleet.ImageName)
+	d.Set("instance_type", fleet.InstanceType)
+	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
+	d.Set("name", fleet.Name)
+	d.Set("state", fleet.State)
+	d.Set("stream_view", fleet.StreamView)
+	if err := d.Set("vpc_config", flattenAppStreamVpcConfig(fleet.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	tags, err := keyvaluetags.AppstreamListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppStream Fleet (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamFleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appstream.UpdateFleetInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("compute_capacity") {
+			if v, ok := d.GetOk("compute_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ComputeCapacity = expandAppStreamComputeCapacity(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("disconnect_timeout_in_seconds") {
+			input.DisconnectTimeoutInSeconds = aws.Int64(int64(d.Get("disconnect_timeout_in_seconds").(int)))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("domain_join_info") {
+			if v, ok := d.GetOk("domain_join_info"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DomainJoinInfo = expandAppStreamDomainJoinInfo(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.FleetAttributeDomainJoinInfo))
+			}
+		}
+
+		if d.HasChange("enable_default_internet_access") {
+			input.EnableDefaultInternetAccess = aws.Bool(d.Get("enable_default_internet_access").(bool))
+		}
+
+		if d.HasChange("iam_role_arn") {
+			if v, ok := d.GetOk("iam_role_arn"); ok {
+				input.IamRoleArn = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.FleetAttributeIamRoleArn))
+			}
+		}
+
+		if d.HasChange("idle_disconnect_timeout_in_seconds") {
+			input.IdleDisconnectTimeoutInSeconds = aws.Int64(int64(d.Get("idle_disconnect_timeout_in_seconds").(int)))
+		}
+
+		if d.HasChange("image_arn") {
+			if v, ok := d.GetOk("image_arn"); ok {
+				input.ImageArn = aws.String(v.(string))
+			}
+		}
+
+		if d.HasChange("image_name") {
+			if v, ok := d.GetOk("image_name"); ok {
+				input.ImageName = aws.String(v.(string))
+			}
+		}
+
+		if d.HasChange("instance_type") {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("max_user_duration_in_seconds") {
+			input.MaxUserDurationInSeconds = aws.Int64(int64(d.Get("max_user_duration_in_seconds").(int)))
+		}
+
+		if d.HasChange("stream_view") {
+			input.StreamView = aws.String(d.Get("stream_view").(string))
+		}
+
+		if d.HasChange("vpc_config") {
+			if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.VpcConfig = expandAppStreamVpcConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.FleetAttributeVpcConfiguration))
+			}
+		}
+
+		// Only some attributes can be changed while the fleet is running.
+		stopRequired := d.HasChangesExcept(
+			"compute_capacity",
+			"description",
+			"disconnect_timeout_in_seconds",
+			"display_name",
+			"idle_disconnect_timeout_in_seconds",
+			"image_arn",
+			"image_name",
+			"tags",
+			"tags_all",
+		)
+
+		if stopRequired {
+			if err := stopAppStreamFleet(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Updating AppStream Fleet: %s", input)
+		_, err := conn.UpdateFleet(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppStream Fleet (%s): %w", d.Id(), err)
+		}
+
+		if stopRequired {
+			if err := startAppStreamFleet(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AppstreamUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppStream Fleet (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppStreamFleetRead(d, meta)
+}
+
+func resourceAwsAppStreamFleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	err := stopAppStreamFleet(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Fleet: %s", d.Id())
+	_, err = conn.DeleteFleet(&appstream.DeleteFleetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Fleet (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// startAppStreamFleet starts the specified fleet, if it is not already running, and waits for it to run.
+func startAppStreamFleet(conn *appstream.AppStream, name string, timeout time.Duration) error {
+	fleet, err := finder.FleetByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Fleet (%s): %w", name, err)
+	}
+
+	if state := aws.StringValue(fleet.State); state == appstream.FleetStateRunning {
+		return nil
+	} else if state != appstream.FleetStateStarting {
+		log.Printf("[DEBUG] Starting AppStream Fleet: %s", name)
+		_, err := conn.StartFleet(&appstream.StartFleetInput{
+			Name: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error starting AppStream Fleet (%s): %w", name, err)
+		}
+	}
+
+	if _, err := waiter.FleetRunning(conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for AppStream Fleet (%s) to start: %w", name, err)
+	}
+
+	return nil
+}
+
+// stopAppStreamFleet stops the specified fleet, if it is not already stopped, and waits for it to stop.
+// A NotFoundError is returned if the fleet does not exist.
+func stopAppStreamFleet(conn *appstream.AppStream, name string, timeout time.Duration) error {
+	fleet, err := finder.FleetByName(conn, name)
+
+	if tfresource.NotFound(err) {
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Fleet (%s): %w", name, err)
+	}
+
+	if state := aws.StringValue(fleet.State); state == appstream.FleetStateStopped {
+		return nil
+	} else if state != appstream.FleetStateStopping {
+		log.Printf("[DEBUG] Stopping AppStream Fleet: %s", name)
+		_, err := conn.StopFleet(&appstream.StopFleetInput{
+			Name: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping AppStream Fleet (%s): %w", name, err)
+		}
+	}
+
+	if _, err := waiter.FleetStopped(conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for AppStream Fleet (%s) to stop: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandAppStreamComputeCapacity(tfMap map[string]interface{}) *appstream.ComputeCapacity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.ComputeCapacity{}
+
+	if v, ok := tfMap["desired_instances"].(int); ok {
+		apiObject.DesiredInstances = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandAppStreamDomainJoinInfo(tfMap map[string]interface{}) *appstream.DomainJoinInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.DomainJoinInfo{}
+
+	if v, ok := tfMap["directory_name"].(string); ok && v != "" {
+		apiObject.DirectoryName = aws.String(v)
+	}
+
+	if v, ok := tfMap["organizational_unit_distinguished_name"].(string); ok && v != "" {
+		apiObject.OrganizationalUnitDistinguishedName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAppStreamVpcConfig(tfMap map[string]interface{}) *appstream.VpcConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.VpcConfig{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamComputeCapacityStatus(apiObject *appstream.ComputeCapacityStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"available":         aws.Int64Value(apiObject.Available),
+		"desired_instances": aws.Int64Value(apiObject.Desired),
+		"in_use":            aws.Int64Value(apiObject.InUse),
+		"running":           aws.Int64Value(apiObject.Running),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAppStreamDomainJoinInfo(apiObject *appstream.DomainJoinInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"directory_name":                         aws.StringValue(apiObject.DirectoryName),
+		"organizational_unit_distinguished_name": aws.StringValue(apiObject.OrganizationalUnitDistinguishedName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAppStreamVpcConfig(apiObject *appstream.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if len(apiObject.SecurityGroupIds) == 0 && len(apiObject.SubnetIds) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_ids":         aws.StringValueSlice(apiObject.SubnetIds),
+	}
+
+	return []interface{}{tfMap}
+}
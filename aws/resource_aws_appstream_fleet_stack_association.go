@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamFleetStackAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamFleetStackAssociationCreate,
+		Read:   resourceAwsAppStreamFleetStackAssociationRead,
+		Delete: resourceAwsAppStreamFleetStackAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fleet_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamFleetStackAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName := d.Get("fleet_name").(string)
+	stackName := d.Get("stack_name").(string)
+	id := tfappstream.FleetStackAssociationCreateResourceID(fleetName, stackName)
+	input := &appstream.AssociateFleetInput{
+		FleetName: aws.String(fleetName),
+		StackName: aws.String(stackName),
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Fleet Stack Association: %s", input)
+	_, err := conn.AssociateFleet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream Fleet Stack Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsAppStreamFleetStackAssociationRead(d, meta)
+}
+
+func resourceAwsAppStreamFleetStackAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = finder.FleetStackAssociationByTwoPartKey(conn, fleetName, stackName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Fleet Stack Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Fleet Stack Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("fleet_name", fleetName)
+	d.Set("stack_name", stackName)
+
+	return nil
+}
+
+func resourceAwsAppStreamFleetStackAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Fleet Stack Association: %s", d.Id())
+	_, err = conn.DisassociateFleet(&appstream.DisassociateFleetInput{
+		FleetName: aws.String(fleetName),
+		StackName: aws.String(stackName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Fleet Stack Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
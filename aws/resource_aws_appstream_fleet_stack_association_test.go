@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAppStreamFleetStackAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet_stack_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetStackAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_name", "aws_appstream_fleet.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", "aws_appstream_stack.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleetStackAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet_stack_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetStackAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamFleetStackAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Fleet Stack Association ID is set")
+		}
+
+		fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err = finder.FleetStackAssociationByTwoPartKey(conn, fleetName, stackName)
+
+		return err
+	}
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_fleet_stack_association" {
+			continue
+		}
+
+		fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.FleetStackAssociationByTwoPartKey(conn, fleetName, stackName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Fleet Stack Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamFleetStackAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+}
+
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_fleet_stack_association" "test" {
+  fleet_name = aws_appstream_fleet.test.name
+  stack_name = aws_appstream_stack.test.name
+}
+`, rName)
+}
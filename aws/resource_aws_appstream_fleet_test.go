@@ -0,0 +1,327 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+		Name: "aws_appstream_fleet",
+		F:    testSweepAppStreamFleets,
+	})
+}
+
+func testSweepAppStreamFleets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeFleetsInput{}
+	var sweeperErrs *multierror.Error
+
+	err = lister.DescribeFleetsPages(conn, input, func(page *appstream.DescribeFleetsOutput, lastPage bool) bool {
+		for _, fleet := range page.Fleets {
+			r := resourceAwsAppStreamFleet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(fleet.Name))
+
+			log.Printf("[INFO] Deleting AppStream Fleet: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream Fleet sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing AppStream Fleets: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamFleet_basic(t *testing.T) {
+	var v appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`fleet/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "1"),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "domain_join_info.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", appstream.FleetTypeOnDemand),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_disappears(t *testing.T) {
+	var v appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_Update(t *testing.T) {
+	var v appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigUpdate(rName, "description1", "stream.standard.small", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "idle_disconnect_timeout_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+			{
+				// Capacity and description can be changed while the fleet is running.
+				Config: testAccAWSAppStreamFleetConfigUpdate(rName, "description2", "stream.standard.small", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+			{
+				// Changing the instance type requires the fleet to be stopped and restarted.
+				Config: testAccAWSAppStreamFleetConfigUpdate(rName, "description2", "stream.standard.medium", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.medium"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_Tags(t *testing.T) {
+	var v appstream.Fleet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamFleetExists(n string, v *appstream.Fleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Fleet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		output, err := finder.FleetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamFleetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_fleet" {
+			continue
+		}
+
+		_, err := finder.FleetByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Fleet %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamFleetConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+}
+`, rName)
+}
+
+func testAccAWSAppStreamFleetConfigUpdate(rName, description, instanceType string, desiredInstances int) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                               = %[1]q
+  description                        = %[2]q
+  display_name                       = %[1]q
+  idle_disconnect_timeout_in_seconds = 60
+  image_name                         = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type                      = %[3]q
+
+  compute_capacity {
+    desired_instances = %[4]d
+  }
+}
+`, rName, description, instanceType, desiredInstances)
+}
+
+func testAccAWSAppStreamFleetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamFleetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+		Name: "aws_appstream_image_builder",
+		F:    testSweepAppStreamImageBuilders,
+	})
+}
+
+func testSweepAppStreamImageBuilders(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeImageBuildersInput{}
+	var sweeperErrs *multierror.Error
+
+	err = lister.DescribeImageBuildersPages(conn, input, func(page *appstream.DescribeImageBuildersOutput, lastPage bool) bool {
+		for _, imageBuilder := range page.ImageBuilders {
+			r := resourceAwsAppStreamImageBuilder()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(imageBuilder.Name))
+
+			log.Printf("[INFO] Deleting AppStream Image Builder: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream Image Builder sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing AppStream Image Builders: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamImageBuilder_basic(t *testing.T) {
+	var v appstream.ImageBuilder
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_image_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamImageBuilderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`image-builder/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.ImageBuilderStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_name"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamImageBuilder_disappears(t *testing.T) {
+	var v appstream.ImageBuilder
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_image_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamImageBuilderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamImageBuilder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamImageBuilder_Tags(t *testing.T) {
+	var v appstream.ImageBuilder
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_image_builder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamImageBuilderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_name"},
+			},
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamImageBuilderExists(n string, v *appstream.ImageBuilder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Image Builder ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		output, err := finder.ImageBuilderByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamImageBuilderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_image_builder" {
+			continue
+		}
+
+		_, err := finder.ImageBuilderByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Image Builder %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamImageBuilderConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+}
+`, rName)
+}
+
+func testAccAWSAppStreamImageBuilderConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamImageBuilderConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,342 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+		Name: "aws_appstream_stack",
+		F:    testSweepAppStreamStacks,
+		Dependencies: []string{
+			"aws_appstream_fleet",
+		},
+	})
+}
+
+func testSweepAppStreamStacks(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeStacksInput{}
+	var sweeperErrs *multierror.Error
+
+	err = lister.DescribeStacksPages(conn, input, func(page *appstream.DescribeStacksOutput, lastPage bool) bool {
+		for _, stack := range page.Stacks {
+			r := resourceAwsAppStreamStack()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(stack.Name))
+
+			log.Printf("[INFO] Deleting AppStream Stack: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream Stack sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing AppStream Stacks: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamStack_basic(t *testing.T) {
+	var v appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`stack/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_disappears(t *testing.T) {
+	var v appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamStack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_StorageConnectorsAndUserSettings(t *testing.T) {
+	var v appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigStorageConnectorsAndUserSettings(rName, "description1", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", "https://example.com/feedback"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", "https://example.com/redirect"),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "storage_connectors.*", map[string]string{
+						"connector_type": appstream.StorageConnectorTypeHomefolders,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyFromLocalDevice,
+						"permission": "ENABLED",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigStorageConnectorsAndUserSettings(rName, "description2", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyFromLocalDevice,
+						"permission": "DISABLED",
+					}),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_Tags(t *testing.T) {
+	var v appstream.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appstream.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamStackExists(n string, v *appstream.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Stack ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		output, err := finder.StackByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAppStreamStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_stack" {
+			continue
+		}
+
+		_, err := finder.StackByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Stack %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAppStreamStackConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAppStreamStackConfigStorageConnectorsAndUserSettings(rName, description, permission string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name         = %[1]q
+  description  = %[2]q
+  display_name = %[1]q
+  feedback_url = "https://example.com/feedback"
+  redirect_url = "https://example.com/redirect"
+
+  storage_connectors {
+    connector_type = "HOMEFOLDERS"
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_FROM_LOCAL_DEVICE"
+    permission = %[3]q
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_TO_LOCAL_DEVICE"
+    permission = %[3]q
+  }
+
+  user_settings {
+    action     = "FILE_UPLOAD"
+    permission = %[3]q
+  }
+
+  user_settings {
+    action     = "FILE_DOWNLOAD"
+    permission = %[3]q
+  }
+
+  user_settings {
+    action     = "PRINTING_TO_LOCAL_DEVICE"
+    permission = %[3]q
+  }
+}
+`, rName, description, permission)
+}
+
+func testAccAWSAppStreamStackConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamStackConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
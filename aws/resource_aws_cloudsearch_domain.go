@@ -0,0 +1,758 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudsearch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudsearch/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudSearchDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudSearchDomainCreate,
+		Read:   resourceAwsCloudSearchDomainRead,
+		Update: resourceAwsCloudSearchDomainUpdate,
+		Delete: resourceAwsCloudSearchDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DomainCreatedTimeout),
+			Update: schema.DefaultTimeout(waiter.DomainUpdatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforce_https": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"tls_security_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(cloudsearch.TLSSecurityPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"index_field": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_scheme": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"facet": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"highlight": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 64),
+								validation.StringMatch(regexp.MustCompile(`^(\*?[a-z][a-z0-9_]*|[a-z][a-z0-9_]*\*?)$`), "must begin with a letter and contain only lowercase letters, numbers and underscores, optionally with a leading or trailing wildcard (*)"),
+							),
+						},
+						"return": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"search": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sort": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"source_fields": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudsearch.IndexFieldType_Values(), false),
+						},
+					},
+				},
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]{2,27}$`),
+					"must begin with a lowercase letter, contain only lowercase letters, numbers and hyphens, and be 3 to 28 characters long"),
+			},
+			"scaling_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_instance_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(cloudsearch.PartitionInstanceType_Values(), false),
+						},
+						"desired_partition_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"desired_replication_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"search_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudSearchDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	name := d.Get("name").(string)
+	input := &cloudsearch.CreateDomainInput{
+		DomainName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating CloudSearch Domain: %s", input)
+	_, err := conn.CreateDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudSearch Domain (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("scaling_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := updateCloudSearchDomainScalingParameters(conn, d.Id(), expandCloudSearchScalingParameters(v.([]interface{})[0].(map[string]interface{}))); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("multi_az"); ok {
+		if err := updateCloudSearchDomainAvailabilityOptions(conn, d.Id(), v.(bool)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("endpoint_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := updateCloudSearchDomainEndpointOptions(conn, d.Id(), expandCloudSearchDomainEndpointOptions(v.([]interface{})[0].(map[string]interface{}))); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("index_field"); ok && v.(*schema.Set).Len() > 0 {
+		if err := defineCloudSearchDomainIndexFields(conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return err
+		}
+
+		if err := indexCloudSearchDomainDocuments(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if _, err := waiter.DomainActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CloudSearch Domain (%s) to create: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudSearchDomainRead(d, meta)
+}
+
+func resourceAwsCloudSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	domain, err := finder.DomainByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudSearch Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudSearch Domain (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", domain.ARN)
+	if domain.DocService != nil {
+		d.Set("document_service_endpoint", domain.DocService.Endpoint)
+	} else {
+		d.Set("document_service_endpoint", nil)
+	}
+	d.Set("domain_id", domain.DomainId)
+	d.Set("name", domain.DomainName)
+	if domain.SearchService != nil {
+		d.Set("search_service_endpoint", domain.SearchService.Endpoint)
+	} else {
+		d.Set("search_service_endpoint", nil)
+	}
+
+	availabilityOptionsOutput, err := conn.DescribeAvailabilityOptions(&cloudsearch.DescribeAvailabilityOptionsInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudSearch Domain (%s) availability options: %w", d.Id(), err)
+	}
+
+	if availabilityOptionsOutput.AvailabilityOptions != nil {
+		d.Set("multi_az", availabilityOptionsOutput.AvailabilityOptions.Options)
+	} else {
+		d.Set("multi_az", nil)
+	}
+
+	endpointOptionsOutput, err := conn.DescribeDomainEndpointOptions(&cloudsearch.DescribeDomainEndpointOptionsInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudSearch Domain (%s) endpoint options: %w", d.Id(), err)
+	}
+
+	if endpointOptionsOutput.DomainEndpointOptions != nil {
+		if err := d.Set("endpoint_options", flattenCloudSearchDomainEndpointOptions(endpointOptionsOutput.DomainEndpointOptions.Options)); err != nil {
+			return fmt.Errorf("error setting endpoint_options: %w", err)
+		}
+	} else {
+		d.Set("endpoint_options", nil)
+	}
+
+	scalingParametersOutput, err := conn.DescribeScalingParameters(&cloudsearch.DescribeScalingParametersInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudSearch Domain (%s) scaling parameters: %w", d.Id(), err)
+	}
+
+	if scalingParametersOutput.ScalingParameters != nil {
+		if err := d.Set("scaling_parameters", flattenCloudSearchScalingParameters(scalingParametersOutput.ScalingParameters.Options)); err != nil {
+			return fmt.Errorf("error setting scaling_parameters: %w", err)
+		}
+	} else {
+		d.Set("scaling_parameters", nil)
+	}
+
+	indexFieldsOutput, err := conn.DescribeIndexFields(&cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudSearch Domain (%s) index fields: %w", d.Id(), err)
+	}
+
+	if err := d.Set("index_field", flattenCloudSearchIndexFieldStatuses(indexFieldsOutput.IndexFields)); err != nil {
+		return fmt.Errorf("error setting index_field: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudSearchDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	if d.HasChange("scaling_parameters") {
+		if v, ok := d.GetOk("scaling_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateCloudSearchDomainScalingParameters(conn, d.Id(), expandCloudSearchScalingParameters(v.([]interface{})[0].(map[string]interface{}))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("multi_az") {
+		if err := updateCloudSearchDomainAvailabilityOptions(conn, d.Id(), d.Get("multi_az").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("endpoint_options") {
+		if v, ok := d.GetOk("endpoint_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateCloudSearchDomainEndpointOptions(conn, d.Id(), expandCloudSearchDomainEndpointOptions(v.([]interface{})[0].(map[string]interface{}))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("index_field") {
+		o, n := d.GetChange("index_field")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Delete the fields that are no longer defined, then (re)define the added and changed fields.
+		newNames := make(map[string]bool)
+		for _, tfMapRaw := range ns.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				newNames[tfMap["name"].(string)] = true
+			}
+		}
+
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			name := tfMap["name"].(string)
+
+			if newNames[name] {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting CloudSearch Domain (%s) index field: %s", d.Id(), name)
+			_, err := conn.DeleteIndexField(&cloudsearch.DeleteIndexFieldInput{
+				DomainName:     aws.String(d.Id()),
+				IndexFieldName: aws.String(name),
+			})
+
+			if tfawserr.ErrCodeEquals(err, cloudsearch.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error deleting CloudSearch Domain (%s) index field (%s): %w", d.Id(), name, err)
+			}
+		}
+
+		if err := defineCloudSearchDomainIndexFields(conn, d.Id(), ns.Difference(os).List()); err != nil {
+			return err
+		}
+
+		if err := indexCloudSearchDomainDocuments(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if _, err := waiter.DomainActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for CloudSearch Domain (%s) to update: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudSearchDomainRead(d, meta)
+}
+
+func resourceAwsCloudSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	log.Printf("[DEBUG] Deleting CloudSearch Domain: %s", d.Id())
+	_, err := conn.DeleteDomain(&cloudsearch.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudsearch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudSearch Domain (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateCloudSearchDomainAvailabilityOptions(conn *cloudsearch.CloudSearch, name string, multiAZ bool) error {
+	input := &cloudsearch.UpdateAvailabilityOptionsInput{
+		DomainName: aws.String(name),
+		MultiAZ:    aws.Bool(multiAZ),
+	}
+
+	log.Printf("[DEBUG] Updating CloudSearch Domain availability options: %s", input)
+	_, err := conn.UpdateAvailabilityOptions(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudSearch Domain (%s) availability options: %w", name, err)
+	}
+
+	return nil
+}
+
+func updateCloudSearchDomainEndpointOptions(conn *cloudsearch.CloudSearch, name string, apiObject *cloudsearch.DomainEndpointOptions) error {
+	input := &cloudsearch.UpdateDomainEndpointOptionsInput{
+		DomainEndpointOptions: apiObject,
+		DomainName:            aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Updating CloudSearch Domain endpoint options: %s", input)
+	_, err := conn.UpdateDomainEndpointOptions(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudSearch Domain (%s) endpoint options: %w", name, err)
+	}
+
+	return nil
+}
+
+func updateCloudSearchDomainScalingParameters(conn *cloudsearch.CloudSearch, name string, apiObject *cloudsearch.ScalingParameters) error {
+	input := &cloudsearch.UpdateScalingParametersInput{
+		DomainName:        aws.String(name),
+		ScalingParameters: apiObject,
+	}
+
+	log.Printf("[DEBUG] Updating CloudSearch Domain scaling parameters: %s", input)
+	_, err := conn.UpdateScalingParameters(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudSearch Domain (%s) scaling parameters: %w", name, err)
+	}
+
+	return nil
+}
+
+func defineCloudSearchDomainIndexFields(conn *cloudsearch.CloudSearch, name string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject, err := expandCloudSearchIndexField(tfMap)
+
+		if err != nil {
+			return err
+		}
+
+		input := &cloudsearch.DefineIndexFieldInput{
+			DomainName: aws.String(name),
+			IndexField: apiObject,
+		}
+
+		log.Printf("[DEBUG] Defining CloudSearch Domain index field: %s", input)
+		_, err = conn.DefineIndexField(input)
+
+		if err != nil {
+			return fmt.Errorf("error defining CloudSearch Domain (%s) index field (%s): %w", name, aws.StringValue(apiObject.IndexFieldName), err)
+		}
+	}
+
+	return nil
+}
+
+func indexCloudSearchDomainDocuments(conn *cloudsearch.CloudSearch, name string) error {
+	log.Printf("[DEBUG] Indexing CloudSearch Domain documents: %s", name)
+	_, err := conn.IndexDocuments(&cloudsearch.IndexDocumentsInput{
+		DomainName: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error indexing CloudSearch Domain (%s) documents: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandCloudSearchDomainEndpointOptions(tfMap map[string]interface{}) *cloudsearch.DomainEndpointOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudsearch.DomainEndpointOptions{}
+
+	if v, ok := tfMap["enforce_https"].(bool); ok {
+		apiObject.EnforceHTTPS = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["tls_security_policy"].(string); ok && v != "" {
+		apiObject.TLSSecurityPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCloudSearchScalingParameters(tfMap map[string]interface{}) *cloudsearch.ScalingParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudsearch.ScalingParameters{}
+
+	if v, ok := tfMap["desired_instance_type"].(string); ok && v != "" {
+		apiObject.DesiredInstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["desired_partition_count"].(int); ok && v != 0 {
+		apiObject.DesiredPartitionCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["desired_replication_count"].(int); ok && v != 0 {
+		apiObject.DesiredReplicationCount = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+// expandCloudSearchIndexField returns the index field with the options for its type.
+// Options that do not apply to the type are ignored.
+func expandCloudSearchIndexField(tfMap map[string]interface{}) (*cloudsearch.IndexField, error) {
+	name := tfMap["name"].(string)
+	fieldType := tfMap["type"].(string)
+	analysisScheme := tfMap["analysis_scheme"].(string)
+	defaultValue := tfMap["default_value"].(string)
+	facet := aws.Bool(tfMap["facet"].(bool))
+	highlight := aws.Bool(tfMap["highlight"].(bool))
+	returnEnabled := aws.Bool(tfMap["return"].(bool))
+	search := aws.Bool(tfMap["search"].(bool))
+	sort := aws.Bool(tfMap["sort"].(bool))
+	sourceFields := tfMap["source_fields"].(string)
+
+	apiObject := &cloudsearch.IndexField{
+		IndexFieldName: aws.String(name),
+		IndexFieldType: aws.String(fieldType),
+	}
+
+	var defaultInt *int64
+	var defaultDouble *float64
+	var defaultString, sourceField *string
+
+	if defaultValue != "" {
+		defaultString = aws.String(defaultValue)
+
+		switch fieldType {
+		case cloudsearch.IndexFieldTypeInt, cloudsearch.IndexFieldTypeIntArray:
+			v, err := strconv.ParseInt(defaultValue, 10, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing CloudSearch index field (%s) default value (%s): %w", name, defaultValue, err)
+			}
+
+			defaultInt = aws.Int64(v)
+		case cloudsearch.IndexFieldTypeDouble, cloudsearch.IndexFieldTypeDoubleArray:
+			v, err := strconv.ParseFloat(defaultValue, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing CloudSearch index field (%s) default value (%s): %w", name, defaultValue, err)
+			}
+
+			defaultDouble = aws.Float64(v)
+		}
+	}
+
+	if sourceFields != "" {
+		sourceField = aws.String(sourceFields)
+	}
+
+	var analysisSchemeName *string
+
+	if analysisScheme != "" {
+		analysisSchemeName = aws.String(analysisScheme)
+	}
+
+	switch fieldType {
+	case cloudsearch.IndexFieldTypeDate:
+		apiObject.DateOptions = &cloudsearch.DateOptions{DefaultValue: defaultString, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SortEnabled: sort, SourceField: sourceField}
+	case cloudsearch.IndexFieldTypeDateArray:
+		apiObject.DateArrayOptions = &cloudsearch.DateArrayOptions{DefaultValue: defaultString, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SourceFields: sourceField}
+	case cloudsearch.IndexFieldTypeDouble:
+		apiObject.DoubleOptions = &cloudsearch.DoubleOptions{DefaultValue: defaultDouble, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SortEnabled: sort, SourceField: sourceField}
+	case cloudsearch.IndexFieldTypeDoubleArray:
+		apiObject.DoubleArrayOptions = &cloudsearch.DoubleArrayOptions{DefaultValue: defaultDouble, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SourceFields: sourceField}
+	case cloudsearch.IndexFieldTypeInt:
+		apiObject.IntOptions = &cloudsearch.IntOptions{DefaultValue: defaultInt, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SortEnabled: sort, SourceField: sourceField}
+	case cloudsearch.IndexFieldTypeIntArray:
+		apiObject.IntArrayOptions = &cloudsearch.IntArrayOptions{DefaultValue: defaultInt, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SourceFields: sourceField}
+	case cloudsearch.IndexFieldTypeLatlon:
+		apiObject.LatLonOptions = &cloudsearch.LatLonOptions{DefaultValue: defaultString, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SortEnabled: sort, SourceField: sourceField}
+	case cloudsearch.IndexFieldTypeLiteral:
+		apiObject.LiteralOptions = &cloudsearch.LiteralOptions{DefaultValue: defaultString, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SortEnabled: sort, SourceField: sourceField}
+	case cloudsearch.IndexFieldTypeLiteralArray:
+		apiObject.LiteralArrayOptions = &cloudsearch.LiteralArrayOptions{DefaultValue: defaultString, FacetEnabled: facet, ReturnEnabled: returnEnabled, SearchEnabled: search, SourceFields: sourceField}
+	case cloudsearch.IndexFieldTypeText:
+		apiObject.TextOptions = &cloudsearch.TextOptions{AnalysisScheme: analysisSchemeName, DefaultValue: defaultString, HighlightEnabled: highlight, ReturnEnabled: returnEnabled, SortEnabled: sort, SourceField: sourceField}
+	case cloudsearch.IndexFieldTypeTextArray:
+		apiObject.TextArrayOptions = &cloudsearch.TextArrayOptions{AnalysisScheme: analysisSchemeName, DefaultValue: defaultString, HighlightEnabled: highlight, ReturnEnabled: returnEnabled, SourceFields: sourceField}
+	default:
+		return nil, fmt.Errorf("unsupported CloudSearch index field (%s) type: %s", name, fieldType)
+	}
+
+	return apiObject, nil
+}
+
+func flattenCloudSearchDomainEndpointOptions(apiObject *cloudsearch.DomainEndpointOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enforce_https":       aws.BoolValue(apiObject.EnforceHTTPS),
+		"tls_security_policy": aws.StringValue(apiObject.TLSSecurityPolicy),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCloudSearchScalingParameters(apiObject *cloudsearch.ScalingParameters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"desired_instance_type":     aws.StringValue(apiObject.DesiredInstanceType),
+		"desired_partition_count":   aws.Int64Value(apiObject.DesiredPartitionCount),
+		"desired_replication_count": aws.Int64Value(apiObject.DesiredReplicationCount),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCloudSearchIndexField(apiObject *cloudsearch.IndexField) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"name": aws.StringValue(apiObject.IndexFieldName),
+		"type": aws.StringValue(apiObject.IndexFieldType),
+	}
+
+	var analysisScheme, defaultValue, sourceFields *string
+	var facet, highlight, returnEnabled, search, sort *bool
+
+	switch aws.StringValue(apiObject.IndexFieldType) {
+	case cloudsearch.IndexFieldTypeDate:
+		if v := apiObject.DateOptions; v != nil {
+			defaultValue, facet, returnEnabled, search, sort, sourceFields = v.DefaultValue, v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SortEnabled, v.SourceField
+		}
+	case cloudsearch.IndexFieldTypeDateArray:
+		if v := apiObject.DateArrayOptions; v != nil {
+			defaultValue, facet, returnEnabled, search, sourceFields = v.DefaultValue, v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SourceFields
+		}
+	case cloudsearch.IndexFieldTypeDouble:
+		if v := apiObject.DoubleOptions; v != nil {
+			if v.DefaultValue != nil {
+				defaultValue = aws.String(strconv.FormatFloat(aws.Float64Value(v.DefaultValue), 'f', -1, 64))
+			}
+			facet, returnEnabled, search, sort, sourceFields = v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SortEnabled, v.SourceField
+		}
+	case cloudsearch.IndexFieldTypeDoubleArray:
+		if v := apiObject.DoubleArrayOptions; v != nil {
+			if v.DefaultValue != nil {
+				defaultValue = aws.String(strconv.FormatFloat(aws.Float64Value(v.DefaultValue), 'f', -1, 64))
+			}
+			facet, returnEnabled, search, sourceFields = v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SourceFields
+		}
+	case cloudsearch.IndexFieldTypeInt:
+		if v := apiObject.IntOptions; v != nil {
+			if v.DefaultValue != nil {
+				defaultValue = aws.String(strconv.FormatInt(aws.Int64Value(v.DefaultValue), 10))
+			}
+			facet, returnEnabled, search, sort, sourceFields = v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SortEnabled, v.SourceField
+		}
+	case cloudsearch.IndexFieldTypeIntArray:
+		if v := apiObject.IntArrayOptions; v != nil {
+			if v.DefaultValue != nil {
+				defaultValue = aws.String(strconv.FormatInt(aws.Int64Value(v.DefaultValue), 10))
+			}
+			facet, returnEnabled, search, sourceFields = v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SourceFields
+		}
+	case cloudsearch.IndexFieldTypeLatlon:
+		if v := apiObject.LatLonOptions; v != nil {
+			defaultValue, facet, returnEnabled, search, sort, sourceFields = v.DefaultValue, v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SortEnabled, v.SourceField
+		}
+	case cloudsearch.IndexFieldTypeLiteral:
+		if v := apiObject.LiteralOptions; v != nil {
+			defaultValue, facet, returnEnabled, search, sort, sourceFields = v.DefaultValue, v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SortEnabled, v.SourceField
+		}
+	case cloudsearch.IndexFieldTypeLiteralArray:
+		if v := apiObject.LiteralArrayOptions; v != nil {
+			defaultValue, facet, returnEnabled, search, sourceFields = v.DefaultValue, v.FacetEnabled, v.ReturnEnabled, v.SearchEnabled, v.SourceFields
+		}
+	case cloudsearch.IndexFieldTypeText:
+		if v := apiObject.TextOptions; v != nil {
+			analysisScheme, defaultValue, highlight, returnEnabled, sort, sourceFields = v.AnalysisScheme, v.DefaultValue, v.HighlightEnabled, v.ReturnEnabled, v.SortEnabled, v.SourceField
+		}
+	case cloudsearch.IndexFieldTypeTextArray:
+		if v := apiObject.TextArrayOptions; v != nil {
+			analysisScheme, defaultValue, highlight, returnEnabled, sourceFields = v.AnalysisScheme, v.DefaultValue, v.HighlightEnabled, v.ReturnEnabled, v.SourceFields
+		}
+	}
+
+	tfMap["analysis_scheme"] = aws.StringValue(analysisScheme)
+	tfMap["default_value"] = aws.StringValue(defaultValue)
+	tfMap["facet"] = aws.BoolValue(facet)
+	tfMap["highlight"] = aws.BoolValue(highlight)
+	tfMap["return"] = aws.BoolValue(returnEnabled)
+	tfMap["search"] = aws.BoolValue(search)
+	tfMap["sort"] = aws.BoolValue(sort)
+	tfMap["source_fields"] = aws.StringValue(sourceFields)
+
+	return tfMap
+}
+
+func flattenCloudSearchIndexFieldStatuses(apiObjects []*cloudsearch.IndexFieldStatus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Options == nil {
+			continue
+		}
+
+		if apiObject.Status != nil && aws.BoolValue(apiObject.Status.PendingDeletion) {
+			continue
+		}
+
+		tfList = append(tfList, flattenCloudSearchIndexField(apiObject.Options))
+	}
+
+	return tfList
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudsearch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudsearch/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudSearchDomainServiceAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudSearchDomainServiceAccessPolicyPut,
+		Read:   resourceAwsCloudSearchDomainServiceAccessPolicyRead,
+		Update: resourceAwsCloudSearchDomainServiceAccessPolicyPut,
+		Delete: resourceAwsCloudSearchDomainServiceAccessPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(waiter.ServiceAccessPoliciesUpdatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.ServiceAccessPoliciesUpdatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudSearchDomainServiceAccessPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	domainName := d.Get("domain_name").(string)
+	input := &cloudsearch.UpdateServiceAccessPoliciesInput{
+		AccessPolicies: aws.String(d.Get("access_policy").(string)),
+		DomainName:     aws.String(domainName),
+	}
+
+	log.Printf("[DEBUG] Putting CloudSearch Domain service access policy: %s", input)
+	_, err := conn.UpdateServiceAccessPolicies(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting CloudSearch Domain (%s) service access policy: %w", domainName, err)
+	}
+
+	d.SetId(domainName)
+
+	if _, err := waiter.ServiceAccessPoliciesActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for CloudSearch Domain (%s) service access policy update: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudSearchDomainServiceAccessPolicyRead(d, meta)
+}
+
+func resourceAwsCloudSearchDomainServiceAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	accessPolicy, err := finder.ServiceAccessPoliciesByDomainName(conn, d.Id())
+
+	if err == nil && aws.StringValue(accessPolicy.Options) == "" {
+		err = &resource.NotFoundError{Message: "Empty result"}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudSearch Domain service access policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudSearch Domain (%s) service access policy: %w", d.Id(), err)
+	}
+
+	d.Set("access_policy", accessPolicy.Options)
+	d.Set("domain_name", d.Id())
+
+	return nil
+}
+
+func resourceAwsCloudSearchDomainServiceAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	input := &cloudsearch.UpdateServiceAccessPoliciesInput{
+		AccessPolicies: aws.String(""),
+		DomainName:     aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudSearch Domain service access policy: %s", input)
+	_, err := conn.UpdateServiceAccessPolicies(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudsearch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudSearch Domain (%s) service access policy: %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ServiceAccessPoliciesActive(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for CloudSearch Domain (%s) service access policy delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudsearch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCloudSearchDomainServiceAccessPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_cloudsearch_domain_service_access_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudsearch.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainServiceAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainServiceAccessPolicyConfig(rName, "search"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainServiceAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_policy"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudSearchDomainServiceAccessPolicyConfig(rName, "*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainServiceAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudSearchDomainServiceAccessPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudSearch Domain service access policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+		output, err := finder.ServiceAccessPoliciesByDomainName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Options) == "" {
+			return fmt.Errorf("CloudSearch Domain %s service access policy is empty", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudSearchDomainServiceAccessPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudsearch_domain_service_access_policy" {
+			continue
+		}
+
+		output, err := finder.ServiceAccessPoliciesByDomainName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Options) == "" {
+			continue
+		}
+
+		return fmt.Errorf("CloudSearch Domain %s service access policy still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudSearchDomainServiceAccessPolicyConfig(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudsearch_domain_service_access_policy" "test" {
+  domain_name = aws_cloudsearch_domain.test.id
+
+  access_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "search_only"
+      Effect = "Allow"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+      Action = "cloudsearch:%[2]s"
+    }]
+  })
+}
+`, rName, action)
+}
@@ -0,0 +1,354 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudsearch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cloudsearch_domain", &resource.Sweeper{
+		Name: "aws_cloudsearch_domain",
+		F:    testSweepCloudSearchDomains,
+	})
+}
+
+func testSweepCloudSearchDomains(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).cloudsearchconn
+	input := &cloudsearch.DescribeDomainsInput{}
+	var sweeperErrs *multierror.Error
+
+	output, err := conn.DescribeDomains(input)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudSearch Domain sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudSearch Domains: %w", err)
+	}
+
+	for _, domain := range output.DomainStatusList {
+		if aws.BoolValue(domain.Deleted) {
+			continue
+		}
+
+		r := resourceAwsCloudSearchDomain()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(domain.DomainName))
+
+		log.Printf("[INFO] Deleting CloudSearch Domain: %s", d.Id())
+		if err := r.Delete(d, client); err != nil {
+			log.Printf("[ERROR] %s", err)
+			sweeperErrs = multierror.Append(sweeperErrs, err)
+		}
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSCloudSearchDomain_basic(t *testing.T) {
+	var v cloudsearch.DomainStatus
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_cloudsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudsearch.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "cloudsearch", regexp.MustCompile(`domain/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "document_service_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_id"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "search_service_endpoint"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudSearchDomain_disappears(t *testing.T) {
+	var v cloudsearch.DomainStatus
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_cloudsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudsearch.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudSearchDomain(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudSearchDomain_IndexFields(t *testing.T) {
+	var v cloudsearch.DomainStatus
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_cloudsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudsearch.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfigIndexFields(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "index_field.*", map[string]string{
+						"name":            "headline",
+						"type":            "text",
+						"analysis_scheme": "_en_default_",
+						"highlight":       "true",
+						"return":          "true",
+						"sort":            "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "index_field.*", map[string]string{
+						"name":          "price",
+						"type":          "double",
+						"default_value": "0",
+						"facet":         "true",
+						"return":        "true",
+						"search":        "true",
+						"sort":          "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudSearchDomainConfigIndexFieldsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "index_field.*", map[string]string{
+						"name":            "headline",
+						"type":            "text",
+						"analysis_scheme": "_en_default_",
+						"highlight":       "false",
+						"return":          "true",
+						"sort":            "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "index_field.*", map[string]string{
+						"name":   "genres",
+						"type":   "literal-array",
+						"facet":  "true",
+						"return": "true",
+						"search": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudSearchDomain_Update(t *testing.T) {
+	var v cloudsearch.DomainStatus
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_cloudsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudsearch.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfigOptions(rName, false, "Policy-Min-TLS-1-0-2019-07", "search.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_options.0.enforce_https", "false"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_options.0.tls_security_policy", "Policy-Min-TLS-1-0-2019-07"),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_instance_type", "search.small"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudSearchDomainConfigOptions(rName, true, "Policy-Min-TLS-1-2-2019-07", "search.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_options.0.enforce_https", "true"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_options.0.tls_security_policy", "Policy-Min-TLS-1-2-2019-07"),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_instance_type", "search.medium"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudSearchDomainExists(n string, v *cloudsearch.DomainStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudSearch Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+		output, err := finder.DomainByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudSearchDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudsearch_domain" {
+			continue
+		}
+
+		_, err := finder.DomainByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudSearch Domain %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudSearchDomainConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCloudSearchDomainConfigIndexFields(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  index_field {
+    name            = "headline"
+    type            = "text"
+    analysis_scheme = "_en_default_"
+    highlight       = true
+    return          = true
+    sort            = true
+  }
+
+  index_field {
+    name          = "price"
+    type          = "double"
+    default_value = "0"
+    facet         = true
+    return        = true
+    search        = true
+    sort          = true
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudSearchDomainConfigIndexFieldsUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  index_field {
+    name            = "headline"
+    type            = "text"
+    analysis_scheme = "_en_default_"
+    highlight       = false
+    return          = true
+    sort            = true
+  }
+
+  index_field {
+    name   = "genres"
+    type   = "literal-array"
+    facet  = true
+    return = true
+    search = true
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudSearchDomainConfigOptions(rName string, multiAZ bool, tlsSecurityPolicy, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name     = %[1]q
+  multi_az = %[2]t
+
+  endpoint_options {
+    enforce_https       = %[2]t
+    tls_security_policy = %[3]q
+  }
+
+  scaling_parameters {
+    desired_instance_type = %[4]q
+  }
+}
+`, rName, multiAZ, tlsSecurityPolicy, instanceType)
+}
@@ -20,9 +20,11 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 		Update: resourceAwsCloudWatchLogGroupUpdate,
 		Delete: resourceAwsCloudWatchLogGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceRegionalImportState,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -55,14 +57,23 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			"region": regionSchema(),
+
 			"tags": tagsSchema(),
+
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
-	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().CloudwatchlogsTags()
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{}))).IgnoreAws().CloudwatchlogsTags()
 
 	var logGroupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -87,7 +98,7 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 		params.Tags = tags
 	}
 
-	_, err := conn.CreateLogGroup(params)
+	_, err = conn.CreateLogGroup(params)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cloudwatchlogs.ErrCodeResourceAlreadyExistsException {
 			return fmt.Errorf("Creating CloudWatch Log Group failed: %s:  The CloudWatch Log Group '%s' already exists.", err, d.Get("name").(string))
@@ -95,7 +106,7 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Creating CloudWatch Log Group failed: %s '%s'", err, d.Get("name"))
 	}
 
-	d.SetId(logGroupName)
+	d.SetId(regionalResourceID(logGroupName, client.region, meta))
 
 	log.Println("[INFO] CloudWatch Log Group created")
 
@@ -116,11 +127,18 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
-	log.Printf("[DEBUG] Reading CloudWatch Log Group: %q", d.Get("name").(string))
-	lg, err := lookupCloudWatchLogGroup(conn, d.Id())
+	name, _ := parseRegionalResourceID(d.Id())
+
+	log.Printf("[DEBUG] Reading CloudWatch Log Group: %q", name)
+	lg, err := lookupCloudWatchLogGroup(conn, name)
 	if err != nil {
 		return err
 	}
@@ -135,17 +153,25 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", lg.LogGroupName)
 	d.Set("kms_key_id", lg.KmsKeyId)
 	d.Set("retention_in_days", lg.RetentionInDays)
+	d.Set("region", client.region)
 
-	tags, err := keyvaluetags.CloudwatchlogsListTags(conn, d.Id())
+	tags, err := keyvaluetags.CloudwatchlogsListTags(conn, name)
 
 	if err != nil {
 		return fmt.Errorf("error listing tags for CloudWatch Logs Group (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -171,14 +197,16 @@ func lookupCloudWatchLogGroup(conn *cloudwatchlogs.CloudWatchLogs, name string)
 }
 
 func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
 
-	name := d.Id()
+	name, _ := parseRegionalResourceID(d.Id())
 	log.Printf("[DEBUG] Updating CloudWatch Log Group: %q", name)
 
 	if d.HasChange("retention_in_days") {
-		var err error
-
 		if v, ok := d.GetOk("retention_in_days"); ok {
 			input := cloudwatchlogs.PutRetentionPolicyInput{
 				LogGroupName:    aws.String(name),
@@ -198,10 +226,10 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if err := keyvaluetags.CloudwatchlogsUpdateTags(conn, d.Id(), o, n); err != nil {
+		if err := keyvaluetags.CloudwatchlogsUpdateTags(conn, name, o, n); err != nil {
 			return fmt.Errorf("error updating CloudWatch Log Group (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -231,9 +259,13 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
 	log.Printf("[INFO] Deleting CloudWatch Log Group: %s", d.Id())
-	_, err := conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
+	_, err = conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
 		LogGroupName: aws.String(d.Get("name").(string)),
 	})
 	if err != nil {
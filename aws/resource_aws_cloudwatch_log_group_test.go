@@ -276,6 +276,45 @@ func TestAccAWSCloudWatchLogGroup_multiple(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchLogGroup_Region(t *testing.T) {
+	var lg cloudwatchlogs.LogGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_log_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchLogGroupConfigRegion(rName, testAccGetAlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogGroupExists(resourceName, &lg),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s@%s", rName, testAccGetAlternateRegion())),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetAlternateRegion()),
+					testAccCheckResourceAttrRegionalARNIgnoreRegionAndAccount(resourceName, "arn", "logs", fmt.Sprintf("log-group:%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchLogGroupConfigRegion(rName, testAccGetRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogGroupExists(resourceName, &lg),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchLogGroup_disappears(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
 	rInt := acctest.RandInt()
@@ -411,8 +450,13 @@ func testAccCheckCloudWatchLogGroupExists(n string, lg *cloudwatchlogs.LogGroup)
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
-		logGroup, err := lookupCloudWatchLogGroup(conn, rs.Primary.ID)
+		name, region := parseRegionalResourceID(rs.Primary.ID)
+		client, err := testAccProvider.Meta().(*AWSClient).RegionalClient(region)
+		if err != nil {
+			return err
+		}
+
+		logGroup, err := lookupCloudWatchLogGroup(client.cloudwatchlogsconn, name)
 		if err != nil {
 			return err
 		}
@@ -427,13 +471,18 @@ func testAccCheckCloudWatchLogGroupExists(n string, lg *cloudwatchlogs.LogGroup)
 }
 
 func testAccCheckAWSCloudWatchLogGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
-
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_group" {
 			continue
 		}
-		logGroup, err := lookupCloudWatchLogGroup(conn, rs.Primary.ID)
+
+		name, region := parseRegionalResourceID(rs.Primary.ID)
+		client, err := testAccProvider.Meta().(*AWSClient).RegionalClient(region)
+		if err != nil {
+			return err
+		}
+
+		logGroup, err := lookupCloudWatchLogGroup(client.cloudwatchlogsconn, name)
 		if err != nil {
 			return nil
 		}
@@ -455,6 +504,15 @@ resource "aws_cloudwatch_log_group" "test" {
 `, rInt)
 }
 
+func testAccAWSCloudWatchLogGroupConfigRegion(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name   = %[1]q
+  region = %[2]q
+}
+`, rName, region)
+}
+
 func testAccAWSCloudWatchLogGroupConfigWithTags(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "test" {
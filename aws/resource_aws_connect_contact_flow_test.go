@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func TestAccAWSConnectContactFlow_basic(t *testing.T) {
+	var v connect.ContactFlow
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(connect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfigBasic(rName, "description1", "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+/contact-flow/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_flow_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", connect.ContactFlowTypeContactFlow),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigBasic(rName, "description2", "Goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`Goodbye`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectContactFlow_Tags(t *testing.T) {
+	var v connect.ContactFlow
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(connect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSConnectContactFlowExists(n string, v *connect.ContactFlow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Contact Flow ID is set")
+		}
+
+		instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.ContactFlowByTwoPartKey(conn, instanceID, contactFlowID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSConnectContactFlowConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccAWSConnectContactFlowConfigContent(message string) string {
+	return fmt.Sprintf(`
+locals {
+  content = jsonencode({
+    Version     = "2019-10-30"
+    StartAction = "12345678-1234-1234-1234-123456789012"
+    Actions = [
+      {
+        Identifier = "12345678-1234-1234-1234-123456789012"
+        Type       = "MessageParticipant"
+        Parameters = {
+          Text = %[1]q
+        }
+        Transitions = {
+          NextAction = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+          Errors     = []
+          Conditions = []
+        }
+      },
+      {
+        Identifier  = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+        Type        = "DisconnectParticipant"
+        Parameters  = {}
+        Transitions = {}
+      }
+    ]
+  })
+}
+`, message)
+}
+
+func testAccAWSConnectContactFlowConfigBasic(rName, description, message string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		testAccAWSConnectContactFlowConfigContent(message),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+  content     = local.content
+}
+`, rName, description))
+}
+
+func testAccAWSConnectContactFlowConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		testAccAWSConnectContactFlowConfigContent("Hello"),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  content     = local.content
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSConnectContactFlowConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		testAccAWSConnectContactFlowConfigContent("Hello"),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  content     = local.content
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,266 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// connectInstanceAttributeTypes maps the boolean instance attribute arguments to their API attribute types.
+var connectInstanceAttributeTypes = map[string]string{
+	"auto_resolve_best_voices_enabled": connect.InstanceAttributeTypeAutoResolveBestVoices,
+	"contact_flow_logs_enabled":        connect.InstanceAttributeTypeContactflowLogs,
+	"contact_lens_enabled":             connect.InstanceAttributeTypeContactLens,
+	"early_media_enabled":              connect.InstanceAttributeTypeEarlyMedia,
+	"inbound_calls_enabled":            connect.InstanceAttributeTypeInboundCalls,
+	"outbound_calls_enabled":           connect.InstanceAttributeTypeOutboundCalls,
+	"use_custom_tts_voices_enabled":    connect.InstanceAttributeTypeUseCustomTtsVoices,
+}
+
+func resourceAwsConnectInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConnectInstanceCreate,
+		Read:   resourceAwsConnectInstanceRead,
+		Update: resourceAwsConnectInstanceUpdate,
+		Delete: resourceAwsConnectInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.InstanceCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.InstanceDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_resolve_best_voices_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"contact_flow_logs_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"contact_lens_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_alias"},
+				ValidateFunc:  validation.StringLenBetween(12, 12),
+			},
+			"early_media_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"identity_management_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.DirectoryType_Values(), false),
+			},
+			"inbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"instance_alias": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"directory_id"},
+				ValidateFunc:  validation.StringLenBetween(1, 62),
+			},
+			"outbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_custom_tts_voices_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsConnectInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	input := &connect.CreateInstanceInput{
+		ClientToken:            aws.String(resource.UniqueId()),
+		IdentityManagementType: aws.String(d.Get("identity_management_type").(string)),
+		InboundCallsEnabled:    aws.Bool(d.Get("inbound_calls_enabled").(bool)),
+		OutboundCallsEnabled:   aws.Bool(d.Get("outbound_calls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		input.DirectoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_alias"); ok {
+		input.InstanceAlias = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Connect Instance: %s", input)
+	output, err := conn.CreateInstance(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Connect Instance: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waiter.InstanceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Connect Instance (%s) to create: %w", d.Id(), err)
+	}
+
+	for key, attributeType := range connectInstanceAttributeTypes {
+		// Inbound and outbound calls are configured when the instance is created.
+		if attributeType == connect.InstanceAttributeTypeInboundCalls || attributeType == connect.InstanceAttributeTypeOutboundCalls {
+			continue
+		}
+
+		if err := updateConnectInstanceAttribute(conn, d.Id(), attributeType, d.Get(key).(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsConnectInstanceRead(d, meta)
+}
+
+func resourceAwsConnectInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	instance, err := finder.InstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Connect Instance (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", instance.Arn)
+	if instance.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(instance.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("identity_management_type", instance.IdentityManagementType)
+	d.Set("instance_alias", instance.InstanceAlias)
+	d.Set("service_role", instance.ServiceRole)
+	d.Set("status", instance.InstanceStatus)
+
+	for key, attributeType := range connectInstanceAttributeTypes {
+		value, err := finder.InstanceAttributeByTwoPartKey(conn, d.Id(), attributeType)
+
+		if err != nil {
+			return fmt.Errorf("error reading Connect Instance (%s) attribute (%s): %w", d.Id(), attributeType, err)
+		}
+
+		enabled, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return fmt.Errorf("error parsing Connect Instance (%s) attribute (%s) value (%s): %w", d.Id(), attributeType, value, err)
+		}
+
+		d.Set(key, enabled)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	for key, attributeType := range connectInstanceAttributeTypes {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		if err := updateConnectInstanceAttribute(conn, d.Id(), attributeType, d.Get(key).(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsConnectInstanceRead(d, meta)
+}
+
+func resourceAwsConnectInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	log.Printf("[DEBUG] Deleting Connect Instance: %s", d.Id())
+	_, err := conn.DeleteInstance(&connect.DeleteInstanceInput{
+		InstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Connect Instance (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.InstanceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Connect Instance (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateConnectInstanceAttribute(conn *connect.Connect, instanceID, attributeType string, enabled bool) error {
+	input := &connect.UpdateInstanceAttributeInput{
+		AttributeType: aws.String(attributeType),
+		InstanceId:    aws.String(instanceID),
+		Value:         aws.String(strconv.FormatBool(enabled)),
+	}
+
+	log.Printf("[DEBUG] Updating Connect Instance attribute: %s", input)
+	_, err := conn.UpdateInstanceAttribute(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Connect Instance (%s) attribute (%s): %w", instanceID, attributeType, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
+		Name: "aws_connect_instance",
+		F:    testSweepConnectInstances,
+	})
+}
+
+func testSweepConnectInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).connectconn
+	input := &connect.ListInstancesInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListInstancesPages(input, func(page *connect.ListInstancesOutput, lastPage bool) bool {
+		for _, instance := range page.InstanceSummaryList {
+			r := resourceAwsConnectInstance()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(instance.Id))
+
+			log.Printf("[INFO] Deleting Connect Instance: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Instance sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Connect Instances: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// Connect limits the number of instances that can be created, so the instance tests are not run in parallel.
+func TestAccAWSConnectInstance_basic(t *testing.T) {
+	var v connect.Instance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(connect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_resolve_best_voices_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "true"),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "early_media_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "identity_management_type", connect.DirectoryTypeConnectManaged),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_alias", rName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_role"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.InstanceStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "use_custom_tts_voices_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectInstance_disappears(t *testing.T) {
+	var v connect.Instance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(connect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConnectInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectInstance_Attributes(t *testing.T) {
+	var v connect.Instance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(connect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfigAttributes(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_resolve_best_voices_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "early_media_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_custom_tts_voices_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectInstanceConfigAttributes(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_resolve_best_voices_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "early_media_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "use_custom_tts_voices_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSConnectInstanceExists(n string, v *connect.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.InstanceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSConnectInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_instance" {
+			continue
+		}
+
+		_, err := finder.InstanceByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSConnectInstanceConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+// testAccAWSConnectInstanceConfigAttributes configures all boolean attributes, setting
+// the attributes that default to true to defaultsEnabled and the rest to !defaultsEnabled.
+// Inbound and outbound calls are set to callsEnabled.
+func testAccAWSConnectInstanceConfigAttributes(rName string, defaultsEnabled, callsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = %[3]t
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = %[3]t
+
+  auto_resolve_best_voices_enabled = %[2]t
+  contact_flow_logs_enabled        = %[4]t
+  contact_lens_enabled             = %[2]t
+  early_media_enabled              = %[2]t
+  use_custom_tts_voices_enabled    = %[4]t
+}
+`, rName, defaultsEnabled, callsEnabled, !defaultsEnabled)
+}
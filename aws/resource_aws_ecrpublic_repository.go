@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecrpublic/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEcrPublicRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrPublicRepositoryCreate,
+		Read:   resourceAwsEcrPublicRepositoryRead,
+		Update: resourceAwsEcrPublicRepositoryUpdate,
+		Delete: resourceAwsEcrPublicRepositoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"catalog_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"about_text": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 10240),
+						},
+						"architectures": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 50),
+							},
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"logo_image_blob": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+						"operating_systems": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 50),
+							},
+						},
+						"usage_text": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 10240),
+						},
+					},
+				},
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 205),
+					validation.StringMatch(regexp.MustCompile(`^(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*$`), "see: https://docs.aws.amazon.com/AmazonECRPublic/latest/APIReference/API_CreateRepository.html#API_CreateRepository_RequestSyntax"),
+				),
+			},
+			"repository_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEcrPublicRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	name := d.Get("repository_name").(string)
+	input := &ecrpublic.CreateRepositoryInput{
+		RepositoryName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("catalog_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		catalogData, err := expandEcrPublicRepositoryCatalogDataInput(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		input.CatalogData = catalogData
+	}
+
+	log.Printf("[DEBUG] Creating ECR Public Repository: %s", input)
+	output, err := conn.CreateRepository(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ECR Public Repository (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Repository.RepositoryName))
+
+	return resourceAwsEcrPublicRepositoryRead(d, meta)
+}
+
+func resourceAwsEcrPublicRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	repository, err := finder.RepositoryByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Public Repository (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Public Repository (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", repository.RepositoryArn)
+	d.Set("registry_id", repository.RegistryId)
+	d.Set("repository_name", repository.RepositoryName)
+	d.Set("repository_uri", repository.RepositoryUri)
+
+	if _, ok := d.GetOk("force_destroy"); !ok {
+		d.Set("force_destroy", false)
+	}
+
+	catalogData, err := finder.RepositoryCatalogDataByName(conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("error reading ECR Public Repository (%s) catalog data: %w", d.Id(), err)
+	}
+
+	// The logo image is not returned by the API, only a URL to it, so keep the configured value.
+	if err := d.Set("catalog_data", flattenEcrPublicRepositoryCatalogData(catalogData, d.Get("catalog_data.0.logo_image_blob").(string))); err != nil {
+		return fmt.Errorf("error setting catalog_data: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEcrPublicRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	if d.HasChange("catalog_data") {
+		input := &ecrpublic.PutRepositoryCatalogDataInput{
+			CatalogData:    &ecrpublic.RepositoryCatalogDataInput{},
+			RepositoryName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("catalog_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			catalogData, err := expandEcrPublicRepositoryCatalogDataInput(v.([]interface{})[0].(map[string]interface{}))
+
+			if err != nil {
+				return err
+			}
+
+			input.CatalogData = catalogData
+		}
+
+		log.Printf("[DEBUG] Updating ECR Public Repository catalog data: %s", input)
+		_, err := conn.PutRepositoryCatalogData(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating ECR Public Repository (%s) catalog data: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEcrPublicRepositoryRead(d, meta)
+}
+
+func resourceAwsEcrPublicRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	log.Printf("[DEBUG] Deleting ECR Public Repository: %s", d.Id())
+	_, err := conn.DeleteRepository(&ecrpublic.DeleteRepositoryInput{
+		Force:          aws.Bool(d.Get("force_destroy").(bool)),
+		RepositoryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecrpublic.ErrCodeRepositoryNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECR Public Repository (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEcrPublicRepositoryCatalogDataInput(tfMap map[string]interface{}) (*ecrpublic.RepositoryCatalogDataInput, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiObject := &ecrpublic.RepositoryCatalogDataInput{}
+
+	if v, ok := tfMap["about_text"].(string); ok && v != "" {
+		apiObject.AboutText = aws.String(v)
+	}
+
+	if v, ok := tfMap["architectures"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Architectures = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["logo_image_blob"].(string); ok && v != "" {
+		logoImageBlob, err := base64.StdEncoding.DecodeString(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding logo_image_blob: %w", err)
+		}
+
+		apiObject.LogoImageBlob = logoImageBlob
+	}
+
+	if v, ok := tfMap["operating_systems"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.OperatingSystems = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["usage_text"].(string); ok && v != "" {
+		apiObject.UsageText = aws.String(v)
+	}
+
+	return apiObject, nil
+}
+
+func flattenEcrPublicRepositoryCatalogData(apiObject *ecrpublic.RepositoryCatalogData, logoImageBlob string) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if aws.StringValue(apiObject.AboutText) == "" && len(apiObject.Architectures) == 0 && aws.StringValue(apiObject.Description) == "" &&
+		aws.StringValue(apiObject.LogoUrl) == "" && len(apiObject.OperatingSystems) == 0 && aws.StringValue(apiObject.UsageText) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"about_text":        aws.StringValue(apiObject.AboutText),
+		"architectures":     aws.StringValueSlice(apiObject.Architectures),
+		"description":       aws.StringValue(apiObject.Description),
+		"logo_image_blob":   logoImageBlob,
+		"operating_systems": aws.StringValueSlice(apiObject.OperatingSystems),
+		"usage_text":        aws.StringValue(apiObject.UsageText),
+	}
+
+	return []interface{}{tfMap}
+}
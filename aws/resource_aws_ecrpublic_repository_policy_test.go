@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecrpublic/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSEcrPublicRepositoryPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryPolicyConfig(rName, "ecr-public:DescribeImages"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryPolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(rName)),
+					testAccCheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_name", "aws_ecrpublic_repository.test", "repository_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEcrPublicRepositoryPolicyConfig(rName, "ecr-public:DescribeImages\", \"ecr-public:DescribeRepositories"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryPolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile("ecr-public:DescribeRepositories")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepositoryPolicy_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryPolicyConfig(rName, "ecr-public:DescribeImages"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEcrPublicRepositoryPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepositoryPolicy_disappears_repository(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryPolicyConfig(rName, "ecr-public:DescribeImages"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryPolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEcrPublicRepository(), "aws_ecrpublic_repository.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcrPublicRepositoryPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Public Repository Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+		_, err := finder.RepositoryPolicyByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSEcrPublicRepositoryPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecrpublic_repository_policy" {
+			continue
+		}
+
+		_, err := finder.RepositoryPolicyByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECR Public Repository Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEcrPublicRepositoryPolicyConfig(rName, actions string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+}
+
+resource "aws_ecrpublic_repository_policy" "test" {
+  repository_name = aws_ecrpublic_repository.test.repository_name
+
+  policy = <<EOF
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Sid": %[1]q,
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": ["%[2]s"]
+    }
+  ]
+}
+EOF
+}
+`, rName, actions)
+}
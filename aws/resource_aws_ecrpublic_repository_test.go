@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecrpublic/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
+		Name: "aws_ecrpublic_repository",
+		F:    testSweepEcrPublicRepositories,
+	})
+}
+
+func testSweepEcrPublicRepositories(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ecrpublicconn
+	input := &ecrpublic.DescribeRepositoriesInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeRepositoriesPages(input, func(page *ecrpublic.DescribeRepositoriesOutput, lastPage bool) bool {
+		for _, repository := range page.Repositories {
+			r := resourceAwsEcrPublicRepository()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(repository.RepositoryName))
+			d.Set("force_destroy", true)
+
+			log.Printf("[INFO] Deleting ECR Public Repository: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping ECR Public Repository sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECR Public Repositories: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSEcrPublicRepository_basic(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					testAccCheckResourceAttrGlobalARNAccountID(resourceName, "arn", testAccGetAccountID(), "ecr-public", "repository/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
+					testAccCheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestCheckResourceAttr(resourceName, "repository_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "repository_uri"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_disappears(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEcrPublicRepository(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_CatalogData(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigCatalogData(rName, "description 1", "about 1", "usage 1", "x86-64", "Linux"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.about_text", "about 1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.architectures.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.architectures.*", "x86-64"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.operating_systems.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.operating_systems.*", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.usage_text", "usage 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigCatalogData(rName, "description 2", "about 2", "usage 2", "ARM 64", "Windows"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.about_text", "about 2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.architectures.*", "ARM 64"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.description", "description 2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "catalog_data.0.operating_systems.*", "Windows"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.usage_text", "usage 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_CatalogData_LogoImageBlob(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigCatalogDataLogoImageBlob(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_data.0.logo_image_blob"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"catalog_data.0.logo_image_blob"},
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrPublicRepository_ForceDestroy(t *testing.T) {
+	var v ecrpublic.Repository
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(ecrpublic.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrPublicRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcrPublicRepositoryConfigForceDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrPublicRepositoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcrPublicRepositoryExists(n string, v *ecrpublic.Repository) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Public Repository ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+		repository, err := finder.RepositoryByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *repository
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEcrPublicRepositoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecrpublic_repository" {
+			continue
+		}
+
+		_, err := finder.RepositoryByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECR Public Repository %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEcrPublicRepositoryConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSEcrPublicRepositoryConfigCatalogData(rName, description, aboutText, usageText, architecture, operatingSystem string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+
+  catalog_data {
+    description       = %[2]q
+    about_text        = %[3]q
+    usage_text        = %[4]q
+    architectures     = [%[5]q]
+    operating_systems = [%[6]q]
+  }
+}
+`, rName, description, aboutText, usageText, architecture, operatingSystem)
+}
+
+func testAccAWSEcrPublicRepositoryConfigCatalogDataLogoImageBlob(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+
+  catalog_data {
+    logo_image_blob = filebase64("test-fixtures/terraform_logo.png")
+  }
+}
+`, rName)
+}
+
+func testAccAWSEcrPublicRepositoryConfigForceDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %[1]q
+  force_destroy   = true
+}
+`, rName)
+}
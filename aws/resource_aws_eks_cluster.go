@@ -1,29 +1,31 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/waiter"
 )
 
 func resourceAwsEksCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsEksClusterCreate,
-		Read:   resourceAwsEksClusterRead,
-		Update: resourceAwsEksClusterUpdate,
-		Delete: resourceAwsEksClusterDelete,
+		CreateContext: resourceAwsEksClusterCreate,
+		ReadContext:   resourceAwsEksClusterRead,
+		UpdateContext: resourceAwsEksClusterUpdate,
+		DeleteContext: resourceAwsEksClusterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -225,7 +227,7 @@ func resourceAwsEksCluster() *schema.Resource {
 	}
 }
 
-func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsEksClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).eksconn
 	name := d.Get("name").(string)
 
@@ -250,8 +252,8 @@ func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Creating EKS Cluster: %s", input)
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.CreateCluster(input)
+	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateClusterWithContext(ctx, input)
 		if err != nil {
 			// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
 			if isAWSErr(err, eks.ErrCodeInvalidParameterException, "does not exist") {
@@ -277,29 +279,22 @@ func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error
 		return nil
 	})
 	if isResourceTimeoutError(err) {
-		_, err = conn.CreateCluster(input)
+		_, err = conn.CreateClusterWithContext(ctx, input)
 	}
 	if err != nil {
-		return fmt.Errorf("error creating EKS Cluster (%s): %s", name, err)
+		return diag.FromErr(fmt.Errorf("error creating EKS Cluster (%s): %w", name, err))
 	}
 
 	d.SetId(name)
 
-	stateConf := resource.StateChangeConf{
-		Pending: []string{eks.ClusterStatusCreating},
-		Target:  []string{eks.ClusterStatusActive},
-		Timeout: d.Timeout(schema.TimeoutCreate),
-		Refresh: refreshEksClusterStatus(conn, name),
-	}
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return err
+	if _, err := waiter.ClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) creation: %w", d.Id(), err))
 	}
 
-	return resourceAwsEksClusterRead(d, meta)
+	return resourceAwsEksClusterRead(ctx, d, meta)
 }
 
-func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsEksClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).eksconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -308,14 +303,14 @@ func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Reading EKS Cluster: %s", input)
-	output, err := conn.DescribeCluster(input)
+	output, err := conn.DescribeClusterWithContext(ctx, input)
 	if err != nil {
 		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] EKS Cluster (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading EKS Cluster (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading EKS Cluster (%s): %w", d.Id(), err))
 	}
 
 	cluster := output.Cluster
@@ -328,19 +323,19 @@ func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", cluster.Arn)
 
 	if err := d.Set("certificate_authority", flattenEksCertificate(cluster.CertificateAuthority)); err != nil {
-		return fmt.Errorf("error setting certificate_authority: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting certificate_authority: %w", err))
 	}
 
 	d.Set("created_at", aws.TimeValue(cluster.CreatedAt).String())
 
 	if err := d.Set("encryption_config", flattenEksEncryptionConfig(cluster.EncryptionConfig)); err != nil {
-		return fmt.Errorf("error setting encryption_config: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting encryption_config: %w", err))
 	}
 
 	d.Set("endpoint", cluster.Endpoint)
 
 	if err := d.Set("identity", flattenEksIdentity(cluster.Identity)); err != nil {
-		return fmt.Errorf("error setting identity: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting identity: %w", err))
 	}
 
 	d.Set("name", cluster.Name)
@@ -349,32 +344,32 @@ func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("status", cluster.Status)
 
 	if err := d.Set("tags", keyvaluetags.EksKeyValueTags(cluster.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	d.Set("version", cluster.Version)
 	if err := d.Set("enabled_cluster_log_types", flattenEksEnabledLogTypes(cluster.Logging)); err != nil {
-		return fmt.Errorf("error setting enabled_cluster_log_types: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting enabled_cluster_log_types: %w", err))
 	}
 
 	if err := d.Set("vpc_config", flattenEksVpcConfigResponse(cluster.ResourcesVpcConfig)); err != nil {
-		return fmt.Errorf("error setting vpc_config: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting vpc_config: %w", err))
 	}
 
 	if err := d.Set("kubernetes_network_config", flattenEksNetworkConfig(cluster.KubernetesNetworkConfig)); err != nil {
-		return fmt.Errorf("error setting kubernetes_network_config: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting kubernetes_network_config: %w", err))
 	}
 
 	return nil
 }
 
-func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsEksClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).eksconn
 
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 		if err := keyvaluetags.EksUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
 		}
 	}
 
@@ -385,21 +380,22 @@ func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) version: %s", d.Id(), input)
-		output, err := conn.UpdateClusterVersion(input)
+		output, err := conn.UpdateClusterVersionWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) version: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating EKS Cluster (%s) version: %w", d.Id(), err))
 		}
 
 		if output == nil || output.Update == nil || output.Update.Id == nil {
-			return fmt.Errorf("error determining EKS Cluster (%s) version update ID: empty response", d.Id())
+			return diag.FromErr(fmt.Errorf("error determining EKS Cluster (%s) version update ID: empty response", d.Id()))
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		err = waitForUpdateEksCluster(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+		_, err = waiter.ClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) version update (%s): %s", d.Id(), updateID, err)
+			return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) version update (%s): %w", d.Id(), updateID, err))
 		}
 	}
 
@@ -411,21 +407,22 @@ func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) logging: %s", d.Id(), input)
-		output, err := conn.UpdateClusterConfig(input)
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) logging: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating EKS Cluster (%s) logging: %w", d.Id(), err))
 		}
 
 		if output == nil || output.Update == nil || output.Update.Id == nil {
-			return fmt.Errorf("error determining EKS Cluster (%s) logging update ID: empty response", d.Id())
+			return diag.FromErr(fmt.Errorf("error determining EKS Cluster (%s) logging update ID: empty response", d.Id()))
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		err = waitForUpdateEksCluster(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+		_, err = waiter.ClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) logging update (%s): %s", d.Id(), updateID, err)
+			return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) logging update (%s): %w", d.Id(), updateID, err))
 		}
 	}
 
@@ -436,50 +433,50 @@ func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) config: %s", d.Id(), input)
-		output, err := conn.UpdateClusterConfig(input)
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) config: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating EKS Cluster (%s) config: %w", d.Id(), err))
 		}
 
 		if output == nil || output.Update == nil || output.Update.Id == nil {
-			return fmt.Errorf("error determining EKS Cluster (%s) config update ID: empty response", d.Id())
+			return diag.FromErr(fmt.Errorf("error determining EKS Cluster (%s) config update ID: empty response", d.Id()))
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		err = waitForUpdateEksCluster(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+		_, err = waiter.ClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) config update (%s): %s", d.Id(), updateID, err)
+			return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) config update (%s): %w", d.Id(), updateID, err))
 		}
 	}
 
-	return resourceAwsEksClusterRead(d, meta)
+	return resourceAwsEksClusterRead(ctx, d, meta)
 }
 
-func resourceAwsEksClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsEksClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).eksconn
 
 	log.Printf("[DEBUG] Deleting EKS Cluster: %s", d.Id())
-	err := deleteEksCluster(conn, d.Id())
+	err := deleteEksCluster(ctx, conn, d.Id())
 	if err != nil {
-		return fmt.Errorf("error deleting EKS Cluster (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting EKS Cluster (%s): %w", d.Id(), err))
 	}
 
-	err = waitForDeleteEksCluster(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
-	if err != nil {
-		return fmt.Errorf("error waiting for EKS Cluster (%s) deletion: %s", d.Id(), err)
+	if _, err := waiter.ClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for EKS Cluster (%s) deletion: %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func deleteEksCluster(conn *eks.EKS, clusterName string) error {
+func deleteEksCluster(ctx context.Context, conn *eks.EKS, clusterName string) error {
 	input := &eks.DeleteClusterInput{
 		Name: aws.String(clusterName),
 	}
 
-	_, err := conn.DeleteCluster(input)
+	_, err := conn.DeleteClusterWithContext(ctx, input)
 	if err != nil {
 		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
 			return nil
@@ -729,98 +726,3 @@ func flattenEksNetworkConfig(apiObject *eks.KubernetesNetworkConfigResponse) []i
 
 	return []interface{}{tfMap}
 }
-
-func refreshEksClusterStatus(conn *eks.EKS, clusterName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
-			Name: aws.String(clusterName),
-		})
-		if err != nil {
-			return 42, "", err
-		}
-		cluster := output.Cluster
-		if cluster == nil {
-			return cluster, "", fmt.Errorf("EKS Cluster (%s) missing", clusterName)
-		}
-		return cluster, aws.StringValue(cluster.Status), nil
-	}
-}
-
-func refreshEksUpdateStatus(conn *eks.EKS, clusterName, updateID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &eks.DescribeUpdateInput{
-			Name:     aws.String(clusterName),
-			UpdateId: aws.String(updateID),
-		}
-
-		output, err := conn.DescribeUpdate(input)
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		if output == nil || output.Update == nil {
-			return nil, "", fmt.Errorf("EKS Cluster (%s) update (%s) missing", clusterName, updateID)
-		}
-
-		return output.Update, aws.StringValue(output.Update.Status), nil
-	}
-}
-
-func waitForDeleteEksCluster(conn *eks.EKS, clusterName string, timeout time.Duration) error {
-	stateConf := resource.StateChangeConf{
-		Pending: []string{
-			eks.ClusterStatusActive,
-			eks.ClusterStatusDeleting,
-		},
-		Target:  []string{""},
-		Timeout: timeout,
-		Refresh: refreshEksClusterStatus(conn, clusterName),
-	}
-	cluster, err := stateConf.WaitForState()
-	if err != nil {
-		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
-			return nil
-		}
-		// Sometimes the EKS API returns the ResourceNotFound error in this form:
-		// ClientException: No cluster found for name: tf-acc-test-0o1f8
-		if isAWSErr(err, eks.ErrCodeClientException, "No cluster found for name:") {
-			return nil
-		}
-	}
-	if cluster == nil {
-		return nil
-	}
-	return err
-}
-
-func waitForUpdateEksCluster(conn *eks.EKS, clusterName, updateID string, timeout time.Duration) error {
-	stateConf := resource.StateChangeConf{
-		Pending: []string{eks.UpdateStatusInProgress},
-		Target: []string{
-			eks.UpdateStatusCancelled,
-			eks.UpdateStatusFailed,
-			eks.UpdateStatusSuccessful,
-		},
-		Timeout: timeout,
-		Refresh: refreshEksUpdateStatus(conn, clusterName, updateID),
-	}
-	updateRaw, err := stateConf.WaitForState()
-
-	if err != nil {
-		return err
-	}
-
-	update := updateRaw.(*eks.Update)
-
-	if aws.StringValue(update.Status) == eks.UpdateStatusSuccessful {
-		return nil
-	}
-
-	var detailedErrors []string
-	for i, updateError := range update.Errors {
-		detailedErrors = append(detailedErrors, fmt.Sprintf("Error %d: Code: %s / Message: %s", i+1, aws.StringValue(updateError.ErrorCode), aws.StringValue(updateError.ErrorMessage)))
-	}
-
-	return fmt.Errorf("EKS Cluster (%s) update (%s) status (%s) not successful: Errors:\n%s", clusterName, updateID, aws.StringValue(update.Status), strings.Join(detailedErrors, "\n"))
-}
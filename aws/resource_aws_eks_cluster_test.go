@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/waiter"
 )
 
 func init() {
@@ -42,12 +44,12 @@ func testSweepEksClusters(region string) error {
 			name := aws.StringValue(cluster)
 
 			log.Printf("[INFO] Deleting EKS Cluster: %s", name)
-			err := deleteEksCluster(conn, name)
+			err := deleteEksCluster(context.Background(), conn, name)
 			if err != nil {
 				errors = multierror.Append(errors, fmt.Errorf("error deleting EKS Cluster %q: %w", name, err))
 				continue
 			}
-			err = waitForDeleteEksCluster(conn, name, 15*time.Minute)
+			_, err = waiter.ClusterDeleted(context.Background(), conn, name, 15*time.Minute)
 			if err != nil {
 				errors = multierror.Append(errors, fmt.Errorf("error waiting for EKS Cluster %q deletion: %w", name, err))
 				continue
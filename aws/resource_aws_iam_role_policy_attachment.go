@@ -9,6 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/readcache"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/finder"
 )
 
 func resourceAwsIamRolePolicyAttachment() *schema.Resource {
@@ -54,10 +56,11 @@ func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta inter
 
 func resourceAwsIamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
+	readCache := meta.(*AWSClient).readCache
 	role := d.Get("role").(string)
 	policyARN := d.Get("policy_arn").(string)
 
-	hasPolicyAttachment, err := iamRoleHasPolicyARNAttachment(conn, role, policyARN)
+	hasPolicyAttachment, err := iamRoleHasPolicyARNAttachment(conn, readCache, role, policyARN)
 
 	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
 		log.Printf("[WARN] IAM Role (%s) not found, removing from state", role)
@@ -130,22 +133,18 @@ func detachPolicyFromRole(conn *iam.IAM, role string, arn string) error {
 	return err
 }
 
-func iamRoleHasPolicyARNAttachment(conn *iam.IAM, role string, policyARN string) (bool, error) {
-	hasPolicyAttachment := false
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(role),
+func iamRoleHasPolicyARNAttachment(conn *iam.IAM, cache *readcache.Cache, role string, policyARN string) (bool, error) {
+	policies, err := finder.RoleAttachedPolicies(conn, cache, role)
+
+	if err != nil {
+		return false, err
 	}
 
-	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
-		for _, p := range page.AttachedPolicies {
-			if aws.StringValue(p.PolicyArn) == policyARN {
-				hasPolicyAttachment = true
-				return false
-			}
+	for _, p := range policies {
+		if aws.StringValue(p.PolicyArn) == policyARN {
+			return true, nil
 		}
+	}
 
-		return !lastPage
-	})
-
-	return hasPolicyAttachment, err
+	return false, nil
 }
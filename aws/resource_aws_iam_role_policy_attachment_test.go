@@ -125,7 +125,7 @@ func testAccCheckAWSRolePolicyAttachmentDestroy(s *terraform.State) error {
 		policyARN := rs.Primary.Attributes["policy_arn"]
 		role := rs.Primary.Attributes["role"]
 
-		hasPolicyAttachment, err := iamRoleHasPolicyARNAttachment(conn, role, policyARN)
+		hasPolicyAttachment, err := iamRoleHasPolicyARNAttachment(conn, nil, role, policyARN)
 
 		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
 			continue
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsChannelCreate,
+		Read:   resourceAwsIotAnalyticsChannelRead,
+		Update: resourceAwsIotAnalyticsChannelUpdate,
+		Delete: resourceAwsIotAnalyticsChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			customdiff.ForceNewIfChange("storage", iotAnalyticsStorageTypeChanged),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name":             iotAnalyticsNameSchema(),
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+			"storage":          iotAnalyticsStorageSchema(),
+			"tags":             tagsSchema(),
+			"tags_all":         tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ChannelStorage = expandIotAnalyticsChannelStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Channel: %s", input)
+	_, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Channel (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	channel, err := finder.ChannelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(channel.Arn)
+	d.Set("arn", arn)
+	d.Set("name", channel.Name)
+
+	if channel.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenIotAnalyticsRetentionPeriod(channel.RetentionPeriod)}); err != nil {
+			return fmt.Errorf("error setting retention_period: %w", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	if channel.Storage != nil {
+		if err := d.Set("storage", []interface{}{flattenIotAnalyticsChannelStorage(channel.Storage)}); err != nil {
+			return fmt.Errorf("error setting storage: %w", err)
+		}
+	} else {
+		d.Set("storage", nil)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Channel (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ChannelStorage = expandIotAnalyticsChannelStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Channel: %s", input)
+		_, err := conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsChannelStorage(tfMap map[string]interface{}) *iotanalytics.ChannelStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.ChannelStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedChannelS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedChannelS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsChannelStorage(apiObject *iotanalytics.ChannelStorage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
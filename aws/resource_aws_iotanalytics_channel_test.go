@@ -0,0 +1,349 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSIotAnalyticsChannel_basic(t *testing.T) {
+	var v iotanalytics.Channel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("channel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_disappears(t *testing.T) {
+	var v iotanalytics.Channel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_Tags(t *testing.T) {
+	var v iotanalytics.Channel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_RetentionPeriod(t *testing.T) {
+	var v iotanalytics.Channel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigRetentionPeriod(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigRetentionPeriod(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_StorageCustomerManagedS3(t *testing.T) {
+	var v iotanalytics.Channel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigStorageCustomerManagedS3(rName, "prefix1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.0.key_prefix", "prefix1/"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigStorageCustomerManagedS3(rName, "prefix2/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.0.key_prefix", "prefix2/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsChannelExists(n string, v *iotanalytics.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.ChannelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_channel" {
+			continue
+		}
+
+		_, err := finder.ChannelByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSIotAnalyticsConfigS3StorageBase returns an S3 bucket and a role that
+// IoT Analytics can use for customer-managed storage.
+func testAccAWSIotAnalyticsConfigS3StorageBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = replace(%[1]q, "_", "-")
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotanalytics.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+        "s3:DeleteObject",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsChannelConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsChannelConfigRetentionPeriod(rName string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+}
+`, rName, days)
+}
+
+func testAccAWSIotAnalyticsChannelConfigStorageCustomerManagedS3(rName, keyPrefix string) string {
+	return composeConfig(testAccAWSIotAnalyticsConfigS3StorageBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  storage {
+    customer_managed_s3 {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = %[2]q
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, keyPrefix))
+}
+
+func testAccAWSIotAnalyticsChannelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotAnalyticsChannelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,1029 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsDataset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatasetCreate,
+		Read:   resourceAwsIotAnalyticsDatasetRead,
+		Update: resourceAwsIotAnalyticsDatasetUpdate,
+		Delete: resourceAwsIotAnalyticsDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"execution_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"resource_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iotanalytics.ComputeType_Values(), false),
+												},
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+											},
+										},
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dataset_content_version_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dataset_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"double_value": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"output_file_uri_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"file_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"string_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"query_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delta_time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"offset_seconds": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"time_expression": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sql_query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iot_events_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
+									"s3_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 255),
+												},
+												"glue_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"database_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+															"table_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+														},
+													},
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"entry_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"late_data_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delta_time_session_window_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_in_minutes": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 60),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"rule_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
+			"name":             iotAnalyticsNameSchema(),
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+			"tags":             tagsSchema(),
+			"tags_all":         tagsSchemaTrulyComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+							ExactlyOneOf: []string{"versioning_configuration.0.max_versions", "versioning_configuration.0.unlimited"},
+						},
+						"unlimited": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ExactlyOneOf: []string{"versioning_configuration.0.max_versions", "versioning_configuration.0.unlimited"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatasetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatasetInput{
+		Actions:     expandIotAnalyticsDatasetActions(d.Get("action").([]interface{})),
+		DatasetName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("content_delivery_rule"); ok && len(v.([]interface{})) > 0 {
+		input.ContentDeliveryRules = expandIotAnalyticsDatasetContentDeliveryRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("late_data_rule"); ok && len(v.([]interface{})) > 0 {
+		input.LateDataRules = expandIotAnalyticsDatasetLateDataRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		input.Triggers = expandIotAnalyticsDatasetTriggers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("versioning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VersioningConfiguration = expandIotAnalyticsDatasetVersioningConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Dataset: %s", input)
+	_, err := conn.CreateDataset(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Dataset (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatasetRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatasetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	dataset, err := finder.DatasetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Dataset (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenIotAnalyticsDatasetActions(dataset.Actions)); err != nil {
+		return fmt.Errorf("error setting action: %w", err)
+	}
+
+	arn := aws.StringValue(dataset.Arn)
+	d.Set("arn", arn)
+
+	if err := d.Set("content_delivery_rule", flattenIotAnalyticsDatasetContentDeliveryRules(dataset.ContentDeliveryRules)); err != nil {
+		return fmt.Errorf("error setting content_delivery_rule: %w", err)
+	}
+
+	if err := d.Set("late_data_rule", flattenIotAnalyticsDatasetLateDataRules(dataset.LateDataRules)); err != nil {
+		return fmt.Errorf("error setting late_data_rule: %w", err)
+	}
+
+	d.Set("name", dataset.Name)
+
+	if dataset.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenIotAnalyticsRetentionPeriod(dataset.RetentionPeriod)}); err != nil {
+			return fmt.Errorf("error setting retention_period: %w", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	if err := d.Set("trigger", flattenIotAnalyticsDatasetTriggers(dataset.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %w", err)
+	}
+
+	if dataset.VersioningConfiguration != nil {
+		if err := d.Set("versioning_configuration", []interface{}{flattenIotAnalyticsDatasetVersioningConfiguration(dataset.VersioningConfiguration)}); err != nil {
+			return fmt.Errorf("error setting versioning_configuration: %w", err)
+		}
+	} else {
+		d.Set("versioning_configuration", nil)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Dataset (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatasetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// UpdateDataset replaces the whole dataset configuration.
+		input := &iotanalytics.UpdateDatasetInput{
+			Actions:              expandIotAnalyticsDatasetActions(d.Get("action").([]interface{})),
+			ContentDeliveryRules: expandIotAnalyticsDatasetContentDeliveryRules(d.Get("content_delivery_rule").([]interface{})),
+			DatasetName:          aws.String(d.Id()),
+			LateDataRules:        expandIotAnalyticsDatasetLateDataRules(d.Get("late_data_rule").([]interface{})),
+			Triggers:             expandIotAnalyticsDatasetTriggers(d.Get("trigger").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("versioning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.VersioningConfiguration = expandIotAnalyticsDatasetVersioningConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Dataset: %s", input)
+		_, err := conn.UpdateDataset(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Dataset (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Dataset (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatasetRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatasetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Dataset: %s", d.Id())
+	_, err := conn.DeleteDataset(&iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Dataset (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsDatasetActions(tfList []interface{}) []*iotanalytics.DatasetAction {
+	var apiObjects []*iotanalytics.DatasetAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetAction{
+			ActionName: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["container_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContainerAction = expandIotAnalyticsContainerDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["query_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.QueryAction = expandIotAnalyticsSqlQueryDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsContainerDatasetAction(tfMap map[string]interface{}) *iotanalytics.ContainerDatasetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.ContainerDatasetAction{
+		ExecutionRoleArn: aws.String(tfMap["execution_role_arn"].(string)),
+		Image:            aws.String(tfMap["image"].(string)),
+	}
+
+	if v, ok := tfMap["resource_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ResourceConfiguration = &iotanalytics.ResourceConfiguration{
+			ComputeType:    aws.String(tfMap["compute_type"].(string)),
+			VolumeSizeInGB: aws.Int64(int64(tfMap["volume_size_in_gb"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["variable"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			variable := &iotanalytics.Variable{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			// Exactly one value must be specified; a double value of 0 can't be distinguished
+			// from an unset one, so it's only used when no other value is configured.
+			if v, ok := tfMap["dataset_content_version_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				variable.DatasetContentVersionValue = &iotanalytics.DatasetContentVersionValue{
+					DatasetName: aws.String(v[0].(map[string]interface{})["dataset_name"].(string)),
+				}
+			} else if v, ok := tfMap["output_file_uri_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				variable.OutputFileUriValue = &iotanalytics.OutputFileUriValue{
+					FileName: aws.String(v[0].(map[string]interface{})["file_name"].(string)),
+				}
+			} else if v, ok := tfMap["string_value"].(string); ok && v != "" {
+				variable.StringValue = aws.String(v)
+			} else {
+				variable.DoubleValue = aws.Float64(tfMap["double_value"].(float64))
+			}
+
+			apiObject.Variables = append(apiObject.Variables, variable)
+		}
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsSqlQueryDatasetAction(tfMap map[string]interface{}) *iotanalytics.SqlQueryDatasetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.SqlQueryDatasetAction{
+		SqlQuery: aws.String(tfMap["sql_query"].(string)),
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			filter := &iotanalytics.QueryFilter{}
+
+			if v, ok := tfMap["delta_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				filter.DeltaTime = &iotanalytics.DeltaTime{
+					OffsetSeconds:  aws.Int64(int64(tfMap["offset_seconds"].(int))),
+					TimeExpression: aws.String(tfMap["time_expression"].(string)),
+				}
+			}
+
+			apiObject.Filters = append(apiObject.Filters, filter)
+		}
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsDatasetContentDeliveryRules(tfList []interface{}) []*iotanalytics.DatasetContentDeliveryRule {
+	var apiObjects []*iotanalytics.DatasetContentDeliveryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetContentDeliveryRule{}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Destination = expandIotAnalyticsDatasetContentDeliveryDestination(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["entry_name"].(string); ok && v != "" {
+			apiObject.EntryName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsDatasetContentDeliveryDestination(tfMap map[string]interface{}) *iotanalytics.DatasetContentDeliveryDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatasetContentDeliveryDestination{}
+
+	if v, ok := tfMap["iot_events_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.IotEventsDestinationConfiguration = &iotanalytics.IotEventsDestinationConfiguration{
+			InputName: aws.String(tfMap["input_name"].(string)),
+			RoleArn:   aws.String(tfMap["role_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3DestinationConfiguration = &iotanalytics.S3DestinationConfiguration{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			Key:     aws.String(tfMap["key"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["glue_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.S3DestinationConfiguration.GlueConfiguration = &iotanalytics.GlueConfiguration{
+				DatabaseName: aws.String(tfMap["database_name"].(string)),
+				TableName:    aws.String(tfMap["table_name"].(string)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsDatasetLateDataRules(tfList []interface{}) []*iotanalytics.LateDataRule {
+	var apiObjects []*iotanalytics.LateDataRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.LateDataRule{
+			RuleConfiguration: &iotanalytics.LateDataRuleConfiguration{},
+		}
+
+		if v, ok := tfMap["rule_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["delta_time_session_window_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.RuleConfiguration.DeltaTimeSessionWindowConfiguration = &iotanalytics.DeltaTimeSessionWindowConfiguration{
+					TimeoutInMinutes: aws.Int64(int64(v[0].(map[string]interface{})["timeout_in_minutes"].(int))),
+				}
+			}
+		}
+
+		if v, ok := tfMap["rule_name"].(string); ok && v != "" {
+			apiObject.RuleName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsDatasetTriggers(tfList []interface{}) []*iotanalytics.DatasetTrigger {
+	var apiObjects []*iotanalytics.DatasetTrigger
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetTrigger{}
+
+		if v, ok := tfMap["dataset"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Dataset = &iotanalytics.TriggeringDataset{
+				Name: aws.String(v[0].(map[string]interface{})["name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["schedule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Schedule = &iotanalytics.Schedule{
+				Expression: aws.String(v[0].(map[string]interface{})["expression"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsDatasetVersioningConfiguration(tfMap map[string]interface{}) *iotanalytics.VersioningConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.VersioningConfiguration{}
+
+	if v, ok := tfMap["max_versions"].(int); ok && v != 0 {
+		apiObject.MaxVersions = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsDatasetActions(apiObjects []*iotanalytics.DatasetAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.ActionName),
+		}
+
+		if v := apiObject.ContainerAction; v != nil {
+			tfMap["container_action"] = []interface{}{flattenIotAnalyticsContainerDatasetAction(v)}
+		}
+
+		if v := apiObject.QueryAction; v != nil {
+			tfMap["query_action"] = []interface{}{flattenIotAnalyticsSqlQueryDatasetAction(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotAnalyticsContainerDatasetAction(apiObject *iotanalytics.ContainerDatasetAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"execution_role_arn": aws.StringValue(apiObject.ExecutionRoleArn),
+		"image":              aws.StringValue(apiObject.Image),
+	}
+
+	if v := apiObject.ResourceConfiguration; v != nil {
+		tfMap["resource_configuration"] = []interface{}{map[string]interface{}{
+			"compute_type":      aws.StringValue(v.ComputeType),
+			"volume_size_in_gb": aws.Int64Value(v.VolumeSizeInGB),
+		}}
+	}
+
+	var tfList []interface{}
+
+	for _, variable := range apiObject.Variables {
+		if variable == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"double_value": aws.Float64Value(variable.DoubleValue),
+			"name":         aws.StringValue(variable.Name),
+			"string_value": aws.StringValue(variable.StringValue),
+		}
+
+		if v := variable.DatasetContentVersionValue; v != nil {
+			tfMap["dataset_content_version_value"] = []interface{}{map[string]interface{}{
+				"dataset_name": aws.StringValue(v.DatasetName),
+			}}
+		}
+
+		if v := variable.OutputFileUriValue; v != nil {
+			tfMap["output_file_uri_value"] = []interface{}{map[string]interface{}{
+				"file_name": aws.StringValue(v.FileName),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	tfMap["variable"] = tfList
+
+	return tfMap
+}
+
+func flattenIotAnalyticsSqlQueryDatasetAction(apiObject *iotanalytics.SqlQueryDatasetAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sql_query": aws.StringValue(apiObject.SqlQuery),
+	}
+
+	var tfList []interface{}
+
+	for _, filter := range apiObject.Filters {
+		if filter == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := filter.DeltaTime; v != nil {
+			tfMap["delta_time"] = []interface{}{map[string]interface{}{
+				"offset_seconds":  aws.Int64Value(v.OffsetSeconds),
+				"time_expression": aws.StringValue(v.TimeExpression),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	tfMap["filter"] = tfList
+
+	return tfMap
+}
+
+func flattenIotAnalyticsDatasetContentDeliveryRules(apiObjects []*iotanalytics.DatasetContentDeliveryRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"entry_name": aws.StringValue(apiObject.EntryName),
+		}
+
+		if v := apiObject.Destination; v != nil {
+			destination := map[string]interface{}{}
+
+			if v := v.IotEventsDestinationConfiguration; v != nil {
+				destination["iot_events_destination_configuration"] = []interface{}{map[string]interface{}{
+					"input_name": aws.StringValue(v.InputName),
+					"role_arn":   aws.StringValue(v.RoleArn),
+				}}
+			}
+
+			if v := v.S3DestinationConfiguration; v != nil {
+				s3DestinationConfiguration := map[string]interface{}{
+					"bucket":   aws.StringValue(v.Bucket),
+					"key":      aws.StringValue(v.Key),
+					"role_arn": aws.StringValue(v.RoleArn),
+				}
+
+				if v := v.GlueConfiguration; v != nil {
+					s3DestinationConfiguration["glue_configuration"] = []interface{}{map[string]interface{}{
+						"database_name": aws.StringValue(v.DatabaseName),
+						"table_name":    aws.StringValue(v.TableName),
+					}}
+				}
+
+				destination["s3_destination_configuration"] = []interface{}{s3DestinationConfiguration}
+			}
+
+			tfMap["destination"] = []interface{}{destination}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotAnalyticsDatasetLateDataRules(apiObjects []*iotanalytics.LateDataRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"rule_name": aws.StringValue(apiObject.RuleName),
+		}
+
+		if v := apiObject.RuleConfiguration; v != nil && v.DeltaTimeSessionWindowConfiguration != nil {
+			tfMap["rule_configuration"] = []interface{}{map[string]interface{}{
+				"delta_time_session_window_configuration": []interface{}{map[string]interface{}{
+					"timeout_in_minutes": aws.Int64Value(v.DeltaTimeSessionWindowConfiguration.TimeoutInMinutes),
+				}},
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotAnalyticsDatasetTriggers(apiObjects []*iotanalytics.DatasetTrigger) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Dataset; v != nil {
+			tfMap["dataset"] = []interface{}{map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.Schedule; v != nil {
+			tfMap["schedule"] = []interface{}{map[string]interface{}{
+				"expression": aws.StringValue(v.Expression),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotAnalyticsDatasetVersioningConfiguration(apiObject *iotanalytics.VersioningConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unlimited": aws.BoolValue(apiObject.Unlimited),
+	}
+
+	if v := apiObject.MaxVersions; v != nil {
+		tfMap["max_versions"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,335 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSIotAnalyticsDataset_basic(t *testing.T) {
+	var v iotanalytics.Dataset
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.container_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.name", "query"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.sql_query", fmt.Sprintf("SELECT * FROM %s", rName)),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("dataset/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDataset_disappears(t *testing.T) {
+	var v iotanalytics.Dataset
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsDataset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDataset_Tags(t *testing.T) {
+	var v iotanalytics.Dataset
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDataset_QueryActionTriggerAndDelivery(t *testing.T) {
+	var v iotanalytics.Dataset
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigQueryActionTriggerAndDelivery(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.offset_seconds", "-60"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.time_expression", "from_unixtime(timestamp)"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.key", "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.0.rule_configuration.0.delta_time_session_window_configuration.0.timeout_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.schedule.0.expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.max_versions", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsDatasetExists(n string, v *iotanalytics.Dataset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Dataset ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.DatasetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsDatasetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_dataset" {
+			continue
+		}
+
+		_, err := finder.DatasetByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Dataset %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotAnalyticsDatasetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsDatasetConfigBasic(rName string) string {
+	return composeConfig(testAccAWSIotAnalyticsDatasetConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsDatasetConfigQueryActionTriggerAndDelivery(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsDatasetConfigBase(rName),
+		testAccAWSIotAnalyticsConfigS3StorageBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+
+      filter {
+        delta_time {
+          offset_seconds  = -60
+          time_expression = "from_unixtime(timestamp)"
+        }
+      }
+    }
+  }
+
+  content_delivery_rule {
+    destination {
+      s3_destination_configuration {
+        bucket   = aws_s3_bucket.test.bucket
+        key      = "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  late_data_rule {
+    rule_configuration {
+      delta_time_session_window_configuration {
+        timeout_in_minutes = 5
+      }
+    }
+  }
+
+  retention_period {
+    number_of_days = 7
+  }
+
+  trigger {
+    schedule {
+      expression = "rate(1 hour)"
+    }
+  }
+
+  versioning_configuration {
+    max_versions = 5
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsDatasetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSIotAnalyticsDatasetConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSIotAnalyticsDatasetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSIotAnalyticsDatasetConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
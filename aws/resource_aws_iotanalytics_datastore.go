@@ -0,0 +1,403 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatastoreCreate,
+		Read:   resourceAwsIotAnalyticsDatastoreRead,
+		Update: resourceAwsIotAnalyticsDatastoreUpdate,
+		Delete: resourceAwsIotAnalyticsDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			customdiff.ForceNewIfChange("storage", iotAnalyticsStorageTypeChanged),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_format_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"file_format_configuration.0.json_configuration", "file_format_configuration.0.parquet_configuration"},
+							Elem: &schema.Resource{
+								// No options currently; just existence of "json_configuration".
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"parquet_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"file_format_configuration.0.json_configuration", "file_format_configuration.0.parquet_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_definition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MinItems: 1,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															"type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 131072),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name":             iotAnalyticsNameSchema(),
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+			"storage":          iotAnalyticsStorageSchema(),
+			"tags":             tagsSchema(),
+			"tags_all":         tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("file_format_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FileFormatConfiguration = expandIotAnalyticsFileFormatConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DatastoreStorage = expandIotAnalyticsDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Datastore: %s", input)
+	_, err := conn.CreateDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Datastore (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	datastore, err := finder.DatastoreByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(datastore.Arn)
+	d.Set("arn", arn)
+
+	if datastore.FileFormatConfiguration != nil {
+		if err := d.Set("file_format_configuration", []interface{}{flattenIotAnalyticsFileFormatConfiguration(datastore.FileFormatConfiguration)}); err != nil {
+			return fmt.Errorf("error setting file_format_configuration: %w", err)
+		}
+	} else {
+		d.Set("file_format_configuration", nil)
+	}
+
+	d.Set("name", datastore.Name)
+
+	if datastore.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenIotAnalyticsRetentionPeriod(datastore.RetentionPeriod)}); err != nil {
+			return fmt.Errorf("error setting retention_period: %w", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	if datastore.Storage != nil {
+		if err := d.Set("storage", []interface{}{flattenIotAnalyticsDatastoreStorage(datastore.Storage)}); err != nil {
+			return fmt.Errorf("error setting storage: %w", err)
+		}
+	} else {
+		d.Set("storage", nil)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Datastore (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DatastoreStorage = expandIotAnalyticsDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Datastore: %s", input)
+		_, err := conn.UpdateDatastore(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastore(&iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsDatastoreStorage(tfMap map[string]interface{}) *iotanalytics.DatastoreStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatastoreStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedDatastoreS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedDatastoreS3Storage{}
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsFileFormatConfiguration(tfMap map[string]interface{}) *iotanalytics.FileFormatConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.FileFormatConfiguration{}
+
+	if v, ok := tfMap["json_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.JsonConfiguration = &iotanalytics.JsonConfiguration{}
+	}
+
+	if v, ok := tfMap["parquet_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ParquetConfiguration = &iotanalytics.ParquetConfiguration{}
+
+		if v[0] == nil {
+			return apiObject
+		}
+
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["schema_definition"].([]interface{}); ok && len(v) > 0 {
+			schemaDefinition := &iotanalytics.SchemaDefinition{}
+
+			if v[0] != nil {
+				for _, tfMapRaw := range v[0].(map[string]interface{})["column"].([]interface{}) {
+					tfMap, ok := tfMapRaw.(map[string]interface{})
+
+					if !ok {
+						continue
+					}
+
+					schemaDefinition.Columns = append(schemaDefinition.Columns, &iotanalytics.Column{
+						Name: aws.String(tfMap["name"].(string)),
+						Type: aws.String(tfMap["type"].(string)),
+					})
+				}
+			}
+
+			apiObject.ParquetConfiguration.SchemaDefinition = schemaDefinition
+		}
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsDatastoreStorage(apiObject *iotanalytics.DatastoreStorage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
+
+func flattenIotAnalyticsFileFormatConfiguration(apiObject *iotanalytics.FileFormatConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.JsonConfiguration != nil {
+		tfMap["json_configuration"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.ParquetConfiguration; v != nil {
+		parquetConfiguration := map[string]interface{}{}
+
+		if v := v.SchemaDefinition; v != nil {
+			var tfList []interface{}
+
+			for _, column := range v.Columns {
+				if column == nil {
+					continue
+				}
+
+				tfList = append(tfList, map[string]interface{}{
+					"name": aws.StringValue(column.Name),
+					"type": aws.StringValue(column.Type),
+				})
+			}
+
+			parquetConfiguration["schema_definition"] = []interface{}{map[string]interface{}{
+				"column": tfList,
+			}}
+		}
+
+		tfMap["parquet_configuration"] = []interface{}{parquetConfiguration}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,307 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSIotAnalyticsDatastore_basic(t *testing.T) {
+	var v iotanalytics.Datastore
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("datastore/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDatastore_disappears(t *testing.T) {
+	var v iotanalytics.Datastore
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDatastore_Tags(t *testing.T) {
+	var v iotanalytics.Datastore
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDatastore_FileFormatConfigurationParquet(t *testing.T) {
+	var v iotanalytics.Datastore
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigFileFormatConfigurationParquet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.type", "string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDatastore_StorageCustomerManagedS3(t *testing.T) {
+	var v iotanalytics.Datastore
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigStorageCustomerManagedS3(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatastoreConfigStorageCustomerManagedS3(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatastoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsDatastoreExists(n string, v *iotanalytics.Datastore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Datastore ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.DatastoreByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsDatastoreDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_datastore" {
+			continue
+		}
+
+		_, err := finder.DatastoreByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Datastore %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotAnalyticsDatastoreConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsDatastoreConfigFileFormatConfigurationParquet(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  file_format_configuration {
+    parquet_configuration {
+      schema_definition {
+        column {
+          name = "device_id"
+          type = "string"
+        }
+
+        column {
+          name = "temperature"
+          type = "double"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsDatastoreConfigStorageCustomerManagedS3(rName string, days int) string {
+	return composeConfig(testAccAWSIotAnalyticsConfigS3StorageBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+
+  storage {
+    customer_managed_s3 {
+      bucket   = aws_s3_bucket.test.bucket
+      role_arn = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, days))
+}
+
+func testAccAWSIotAnalyticsDatastoreConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotAnalyticsDatastoreConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
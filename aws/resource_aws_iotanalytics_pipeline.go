@@ -0,0 +1,588 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsPipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsPipelineCreate,
+		Read:   resourceAwsIotAnalyticsPipelineRead,
+		Update: resourceAwsIotAnalyticsPipelineUpdate,
+		Delete: resourceAwsIotAnalyticsPipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": iotAnalyticsPipelineActivityNameSchema(),
+									"next": iotAnalyticsPipelineActivityNextSchema(),
+								},
+							},
+						},
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name": iotAnalyticsPipelineActivityNameSchema(),
+									"next": iotAnalyticsPipelineActivityNextSchema(),
+								},
+							},
+						},
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name": iotAnalyticsPipelineActivityNameSchema(),
+								},
+							},
+						},
+						"device_registry_enrich": iotAnalyticsPipelineActivityEnrichSchema(),
+						"device_shadow_enrich":   iotAnalyticsPipelineActivityEnrichSchema(),
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": iotAnalyticsPipelineActivityNameSchema(),
+									"next": iotAnalyticsPipelineActivityNextSchema(),
+								},
+							},
+						},
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"lambda_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"name": iotAnalyticsPipelineActivityNameSchema(),
+									"next": iotAnalyticsPipelineActivityNextSchema(),
+								},
+							},
+						},
+						"math": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"math": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": iotAnalyticsPipelineActivityNameSchema(),
+									"next": iotAnalyticsPipelineActivityNextSchema(),
+								},
+							},
+						},
+						"remove_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": iotAnalyticsPipelineActivityAttributesSchema(),
+									"name":       iotAnalyticsPipelineActivityNameSchema(),
+									"next":       iotAnalyticsPipelineActivityNextSchema(),
+								},
+							},
+						},
+						"select_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": iotAnalyticsPipelineActivityAttributesSchema(),
+									"name":       iotAnalyticsPipelineActivityNameSchema(),
+									"next":       iotAnalyticsPipelineActivityNextSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name":     iotAnalyticsNameSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func iotAnalyticsPipelineActivityNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+}
+
+func iotAnalyticsPipelineActivityNextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+}
+
+func iotAnalyticsPipelineActivityAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 50,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+	}
+}
+
+func iotAnalyticsPipelineActivityEnrichSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				"name": iotAnalyticsPipelineActivityNameSchema(),
+				"next": iotAnalyticsPipelineActivityNextSchema(),
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"thing_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsPipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandIotAnalyticsPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Pipeline: %s", input)
+	_, err := conn.CreatePipeline(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Pipeline (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsPipelineRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsPipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	pipeline, err := finder.PipelineByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Pipeline (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenIotAnalyticsPipelineActivities(pipeline.Activities)); err != nil {
+		return fmt.Errorf("error setting activity: %w", err)
+	}
+
+	arn := aws.StringValue(pipeline.Arn)
+	d.Set("arn", arn)
+	d.Set("name", pipeline.Name)
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Pipeline (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChange("activity") {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandIotAnalyticsPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Pipeline: %s", input)
+		_, err := conn.UpdatePipeline(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Pipeline (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Pipeline (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsPipelineRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsPipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Pipeline: %s", d.Id())
+	_, err := conn.DeletePipeline(&iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Pipeline (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsPipelineActivities(tfList []interface{}) []*iotanalytics.PipelineActivity {
+	var apiObjects []*iotanalytics.PipelineActivity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.PipelineActivity{}
+
+		if v, ok := tfMap["add_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.AddAttributes = &iotanalytics.AddAttributesActivity{
+				Attributes: stringMapToPointers(tfMap["attributes"].(map[string]interface{})),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandIotAnalyticsPipelineActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["channel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Channel = &iotanalytics.ChannelActivity{
+				ChannelName: aws.String(tfMap["channel_name"].(string)),
+				Name:        aws.String(tfMap["name"].(string)),
+				Next:        expandIotAnalyticsPipelineActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["datastore"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Datastore = &iotanalytics.DatastoreActivity{
+				DatastoreName: aws.String(tfMap["datastore_name"].(string)),
+				Name:          aws.String(tfMap["name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["device_registry_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      aws.String(tfMap["name"].(string)),
+				Next:      expandIotAnalyticsPipelineActivityNext(tfMap),
+				RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["device_shadow_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      aws.String(tfMap["name"].(string)),
+				Next:      expandIotAnalyticsPipelineActivityNext(tfMap),
+				RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Filter = &iotanalytics.FilterActivity{
+				Filter: aws.String(tfMap["filter"].(string)),
+				Name:   aws.String(tfMap["name"].(string)),
+				Next:   expandIotAnalyticsPipelineActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Lambda = &iotanalytics.LambdaActivity{
+				BatchSize:  aws.Int64(int64(tfMap["batch_size"].(int))),
+				LambdaName: aws.String(tfMap["lambda_name"].(string)),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandIotAnalyticsPipelineActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["math"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Math = &iotanalytics.MathActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Math:      aws.String(tfMap["math"].(string)),
+				Name:      aws.String(tfMap["name"].(string)),
+				Next:      expandIotAnalyticsPipelineActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["remove_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+				Attributes: expandStringList(tfMap["attributes"].([]interface{})),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandIotAnalyticsPipelineActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["select_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+				Attributes: expandStringList(tfMap["attributes"].([]interface{})),
+				Name:       aws.String(tfMap["name"].(string)),
+				Next:       expandIotAnalyticsPipelineActivityNext(tfMap),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsPipelineActivityNext(tfMap map[string]interface{}) *string {
+	if v, ok := tfMap["next"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenIotAnalyticsPipelineActivities(apiObjects []*iotanalytics.PipelineActivity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AddAttributes; v != nil {
+			tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueMap(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Channel; v != nil {
+			tfMap["channel"] = []interface{}{map[string]interface{}{
+				"channel_name": aws.StringValue(v.ChannelName),
+				"name":         aws.StringValue(v.Name),
+				"next":         aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Datastore; v != nil {
+			tfMap["datastore"] = []interface{}{map[string]interface{}{
+				"datastore_name": aws.StringValue(v.DatastoreName),
+				"name":           aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.DeviceRegistryEnrich; v != nil {
+			tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.DeviceShadowEnrich; v != nil {
+			tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = []interface{}{map[string]interface{}{
+				"filter": aws.StringValue(v.Filter),
+				"name":   aws.StringValue(v.Name),
+				"next":   aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{map[string]interface{}{
+				"batch_size":  aws.Int64Value(v.BatchSize),
+				"lambda_name": aws.StringValue(v.LambdaName),
+				"name":        aws.StringValue(v.Name),
+				"next":        aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Math; v != nil {
+			tfMap["math"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Attribute),
+				"math":      aws.StringValue(v.Math),
+				"name":      aws.StringValue(v.Name),
+				"next":      aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.RemoveAttributes; v != nil {
+			tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.SelectAttributes; v != nil {
+			tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,353 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSIotAnalyticsPipeline_basic(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "source"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "sink"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_datastore.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "sink"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("pipeline/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_disappears(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsPipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_Tags(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_Activities(t *testing.T) {
+	var v iotanalytics.Pipeline
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigActivities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "filter"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 0"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.attribute", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.device", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.0", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "activity.5.datastore.0.name", "sink"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsPipelineExists(n string, v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsPipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_pipeline" {
+			continue
+		}
+
+		_, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Pipeline %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBasic(rName string) string {
+	return composeConfig(testAccAWSIotAnalyticsPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "source"
+      next         = "sink"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "sink"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigActivities(rName string) string {
+	return composeConfig(testAccAWSIotAnalyticsPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "source"
+      next         = "filter"
+    }
+  }
+
+  activity {
+    filter {
+      filter = "temperature > 0"
+      name   = "filter"
+      next   = "math"
+    }
+  }
+
+  activity {
+    math {
+      attribute = "temperature_f"
+      math      = "temperature * 9 / 5 + 32"
+      name      = "math"
+      next      = "add"
+    }
+  }
+
+  activity {
+    add_attributes {
+      attributes = {
+        device = "device_id"
+      }
+      name = "add"
+      next = "remove"
+    }
+  }
+
+  activity {
+    remove_attributes {
+      attributes = ["temperature"]
+      name       = "remove"
+      next       = "sink"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "sink"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSIotAnalyticsPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "source"
+      next         = "sink"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "sink"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSIotAnalyticsPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "source"
+      next         = "sink"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "sink"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSIotEventsDetectorModel_basic(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodBatch),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", iotevents.DetectorModelVersionStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_disappears(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Tags(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_update(t *testing.T) {
+	var v iotevents.DetectorModel
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodBatch),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`> 40`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodSerial),
+					resource.TestCheckResourceAttr(resourceName, "status", iotevents.DetectorModelVersionStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsDetectorModelExists(n string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		output, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotEventsDetectorModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		_, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotEventsDetectorModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelConfigDefinition(threshold int) string {
+	return fmt.Sprintf(`
+  definition = jsonencode({
+    initialStateName = "Normal"
+    states = [
+      {
+        stateName = "Normal"
+        onInput = {
+          transitionEvents = [{
+            eventName = "Overheated"
+            condition = "$input.${aws_iotevents_input.test.name}.temperature > %[1]d"
+            nextState = "Alarm"
+          }]
+        }
+      },
+      {
+        stateName = "Alarm"
+        onInput = {
+          transitionEvents = [{
+            eventName = "Cooled"
+            condition = "$input.${aws_iotevents_input.test.name}.temperature <= %[1]d"
+            nextState = "Normal"
+          }]
+        }
+      },
+    ]
+  })
+`, threshold)
+}
+
+func testAccAWSIotEventsDetectorModelConfigBasic(rName string) string {
+	return composeConfig(testAccAWSIotEventsDetectorModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+}
+`, rName, testAccAWSIotEventsDetectorModelConfigDefinition(30)))
+}
+
+func testAccAWSIotEventsDetectorModelConfigUpdated(rName string) string {
+	return composeConfig(testAccAWSIotEventsDetectorModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name              = %[1]q
+  description       = "updated"
+  evaluation_method = "SERIAL"
+  role_arn          = aws_iam_role.test.arn
+%[2]s
+}
+`, rName, testAccAWSIotEventsDetectorModelConfigDefinition(40)))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSIotEventsDetectorModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccAWSIotEventsDetectorModelConfigDefinition(30)))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSIotEventsDetectorModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccAWSIotEventsDetectorModelConfigDefinition(30)))
+}
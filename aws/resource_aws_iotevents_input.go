@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotEventsInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsInputCreate,
+		Read:   resourceAwsIotEventsInputRead,
+		Update: resourceAwsIotEventsInputUpdate,
+		Delete: resourceAwsIotEventsInputDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 200,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"json_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotEventsInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateInputInput{
+		InputName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputDefinition = expandIotEventsInputDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.InputDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Input: %s", input)
+	_, err := conn.CreateInput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Input (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.InputActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Input (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsInputRead(d, meta)
+}
+
+func resourceAwsIotEventsInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.InputByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Input (%s): %w", d.Id(), err)
+	}
+
+	configuration := output.InputConfiguration
+	arn := aws.StringValue(configuration.InputArn)
+	d.Set("arn", arn)
+	if output.InputDefinition != nil {
+		if err := d.Set("definition", []interface{}{flattenIotEventsInputDefinition(output.InputDefinition)}); err != nil {
+			return fmt.Errorf("error setting definition: %w", err)
+		}
+	} else {
+		d.Set("definition", nil)
+	}
+	d.Set("description", configuration.InputDescription)
+	d.Set("name", configuration.InputName)
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Input (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateInputInput{
+			InputName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.InputDefinition = expandIotEventsInputDefinition(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		input.InputDescription = aws.String(d.Get("description").(string))
+
+		log.Printf("[DEBUG] Updating IoT Events Input: %s", input)
+		_, err := conn.UpdateInput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Input (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.InputActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Input (%s) to become active: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Input (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsInputRead(d, meta)
+}
+
+func resourceAwsIotEventsInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Input: %s", d.Id())
+	_, err := conn.DeleteInput(&iotevents.DeleteInputInput{
+		InputName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Input (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.InputDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Input (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotEventsInputDefinition(tfMap map[string]interface{}) *iotevents.InputDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.InputDefinition{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Attributes = append(apiObject.Attributes, &iotevents.Attribute{
+				JsonPath: aws.String(tfMap["json_path"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenIotEventsInputDefinition(apiObject *iotevents.InputDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, attribute := range apiObject.Attributes {
+		if attribute == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"json_path": aws.StringValue(attribute.JsonPath),
+		})
+	}
+
+	return map[string]interface{}{
+		"attribute": tfList,
+	}
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSIotEventsInput_basic(t *testing.T) {
+	var v iotevents.Input
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("input/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_disappears(t *testing.T) {
+	var v iotevents.Input
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsInput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_Tags(t *testing.T) {
+	var v iotevents.Input
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_update(t *testing.T) {
+	var v iotevents.Input
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.attribute.1.json_path", "sensor.id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsInputExists(n string, v *iotevents.Input) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Input ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		output, err := finder.InputByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotEventsInputDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_input" {
+			continue
+		}
+
+		_, err := finder.InputByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Input %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotEventsInputConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsInputConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+
+    attribute {
+      json_path = "sensor.id"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsInputConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotEventsInputConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsMacie2Account_basic(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+					testAccCheckResourceAttrGlobalARN(resourceName, "service_role", "iam", "role/aws-service-role/macie.amazonaws.com/AWSServiceRoleForAmazonMacie"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_disappears(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Account(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_FindingPublishingFrequency(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencyOneHour),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyOneHour),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencySixHours),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencySixHours),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_Status(t *testing.T) {
+	var macie2Output macie2.GetMacieSessionOutput
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigStatus(macie2.MacieStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigStatus(macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2AccountExists(n string, v *macie2.GetMacieSessionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.MacieSession(conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2AccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_account" {
+			continue
+		}
+
+		_, err := finder.MacieSession(conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2AccountConfigBasic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+`
+}
+
+func testAccAwsMacie2AccountConfigFindingPublishingFrequency(frequency string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  finding_publishing_frequency = %[1]q
+}
+`, frequency)
+}
+
+func testAccAwsMacie2AccountConfigStatus(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  status = %[1]q
+}
+`, status)
+}
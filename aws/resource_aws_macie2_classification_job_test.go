@@ -0,0 +1,376 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsMacie2ClassificationJob_basic(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigJobType(rName, macie2.JobTypeOneTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "job_arn", "macie2", regexp.MustCompile(`classification-job/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeOneTime),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.#", "1"),
+					testAccCheckResourceAttrAccountID(resourceName, "s3_job_definition.0.bucket_definitions.0.account_id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.0", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_disappears(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigJobType(rName, macie2.JobTypeOneTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2ClassificationJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_JobStatus(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusRunning),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusUserPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusUserPaused),
+					resource.TestCheckResourceAttr(resourceName, "user_paused_details.#", "1"),
+					testAccCheckResourceAttrRfc3339(resourceName, "user_paused_details.0.job_paused_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_Scheduled(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "sampling_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.weekly_schedule", macie2.DayOfWeekMonday),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.comparator", macie2.JobComparatorEq),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.key", macie2.ScopeFilterKeyObjectExtension),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.values.0", "png"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_Tags(t *testing.T) {
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2ClassificationJobExists(n string, v *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Classification Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.ClassificationJobByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2ClassificationJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_job" {
+			continue
+		}
+
+		output, err := finder.ClassificationJobByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Jobs cannot be deleted, only cancelled.
+		switch aws.StringValue(output.JobStatus) {
+		case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+			continue
+		}
+
+		return fmt.Errorf("Macie Classification Job %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2ClassificationJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsMacie2ClassificationJobConfigJobType(rName, jobType string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = %[2]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, jobType))
+}
+
+func testAccAwsMacie2ClassificationJobConfigJobStatus(rName, jobStatus string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name       = %[1]q
+  job_type   = "SCHEDULED"
+  job_status = %[2]q
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, jobStatus))
+}
+
+func testAccAwsMacie2ClassificationJobConfigScheduled(rName string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name                = %[1]q
+  job_type            = "SCHEDULED"
+  initial_run         = true
+  sampling_percentage = 50
+
+  schedule_frequency {
+    weekly_schedule = "MONDAY"
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+
+    scoping {
+      excludes {
+        and {
+          simple_scope_term {
+            comparator = "EQ"
+            key        = "OBJECT_EXTENSION"
+            values     = ["png"]
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName))
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "ONE_TIME"
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAwsMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "ONE_TIME"
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsMacie2CustomDataIdentifier_basic(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigName("", regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "macie2", regexp.MustCompile(`custom-data-identifier/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+					naming.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
+					resource.TestCheckResourceAttr(resourceName, "regex", regex),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_disappears(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigName("", regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2CustomDataIdentifier(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_NamePrefix(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigNamePrefix("tf-acc-test-prefix-", regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_Complete(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigComplete(rName, regex),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_words.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ignore_words.*", "ignore"),
+					resource.TestCheckResourceAttr(resourceName, "keywords.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "keywords.*", "keyword"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_match_distance", "10"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "regex", regex),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_Tags(t *testing.T) {
+	var macie2Output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, regex, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags2(rName, regex, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, regex, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierExists(n string, v *macie2.GetCustomDataIdentifierOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Custom Data Identifier ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.CustomDataIdentifierByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_custom_data_identifier" {
+			continue
+		}
+
+		_, err := finder.CustomDataIdentifierByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Custom Data Identifier %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigName(name, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = %[2]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, name, regex)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigNamePrefix(namePrefix, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name_prefix = %[1]q
+  regex       = %[2]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, namePrefix, regex)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigComplete(rName, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name                   = %[1]q
+  regex                  = %[2]q
+  description            = "test description"
+  maximum_match_distance = 10
+  keywords               = ["keyword"]
+  ignore_words           = ["ignore"]
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, regex)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, regex, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, regex, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags2(rName, regex, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, regex, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,387 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMacie2FindingsFilter() *schema.Resource {
+	integerStringValidateFunc := validation.StringMatch(regexp.MustCompile(`^-?[0-9]+$`), "must be an integer")
+
+	return &schema.Resource{
+		Create: resourceAwsMacie2FindingsFilterCreate,
+		Read:   resourceAwsMacie2FindingsFilterRead,
+		Update: resourceAwsMacie2FindingsFilterUpdate,
+		Delete: resourceAwsMacie2FindingsFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.FindingsFilterAction_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criterion": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eq": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"eq_exact_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"gt": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerStringValidateFunc,
+									},
+									"gte": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerStringValidateFunc,
+									},
+									"lt": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerStringValidateFunc,
+									},
+									"lte": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: integerStringValidateFunc,
+									},
+									"neq": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(3, 64),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(0, 64-resource.UniqueIDSuffixLength),
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMacie2FindingsFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	findingCriteria, err := expandMacie2FindingCriteria(d.Get("finding_criteria").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &macie2.CreateFindingsFilterInput{
+		Action:          aws.String(d.Get("action").(string)),
+		ClientToken:     aws.String(resource.UniqueId()),
+		FindingCriteria: findingCriteria,
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		input.Position = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Findings Filter: %s", input)
+	output, err := conn.CreateFindingsFilter(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Findings Filter (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceAwsMacie2FindingsFilterRead(d, meta)
+}
+
+func resourceAwsMacie2FindingsFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.FindingsFilterByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Findings Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Findings Filter (%s): %w", d.Id(), err)
+	}
+
+	d.Set("action", output.Action)
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+
+	if err := d.Set("finding_criteria", flattenMacie2FindingCriteria(output.FindingCriteria)); err != nil {
+		return fmt.Errorf("error setting finding_criteria: %w", err)
+	}
+
+	d.Set("name", output.Name)
+	d.Set("name_prefix", aws.StringValue(naming.NamePrefixFromName(aws.StringValue(output.Name))))
+	d.Set("position", output.Position)
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2FindingsFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &macie2.UpdateFindingsFilterInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			input.Action = aws.String(d.Get("action").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("finding_criteria") {
+			findingCriteria, err := expandMacie2FindingCriteria(d.Get("finding_criteria").([]interface{}))
+
+			if err != nil {
+				return err
+			}
+
+			input.FindingCriteria = findingCriteria
+		}
+
+		if d.HasChanges("name", "name_prefix") {
+			input.Name = aws.String(naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string)))
+		}
+
+		if d.HasChange("position") {
+			input.Position = aws.Int64(int64(d.Get("position").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating Macie Findings Filter: %s", input)
+		_, err := conn.UpdateFindingsFilter(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Macie Findings Filter (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Findings Filter (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2FindingsFilterRead(d, meta)
+}
+
+func resourceAwsMacie2FindingsFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Deleting Macie Findings Filter: %s", d.Id())
+	_, err := conn.DeleteFindingsFilter(&macie2.DeleteFindingsFilterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Findings Filter (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMacie2FindingCriteria(tfList []interface{}) (*macie2.FindingCriteria, error) {
+	apiObject := &macie2.FindingCriteria{
+		Criterion: map[string]*macie2.CriterionAdditionalProperties{},
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	for _, criterionRaw := range tfMap["criterion"].(*schema.Set).List() {
+		criterion, ok := criterionRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		properties := &macie2.CriterionAdditionalProperties{}
+
+		if v, ok := criterion["eq"].(*schema.Set); ok && v.Len() > 0 {
+			properties.Eq = expandStringSet(v)
+		}
+
+		if v, ok := criterion["eq_exact_match"].(*schema.Set); ok && v.Len() > 0 {
+			properties.EqExactMatch = expandStringSet(v)
+		}
+
+		if v, ok := criterion["neq"].(*schema.Set); ok && v.Len() > 0 {
+			properties.Neq = expandStringSet(v)
+		}
+
+		for key, field := range map[string]**int64{
+			"gt":  &properties.Gt,
+			"gte": &properties.Gte,
+			"lt":  &properties.Lt,
+			"lte": &properties.Lte,
+		} {
+			v, ok := criterion[key].(string)
+
+			if !ok || v == "" {
+				continue
+			}
+
+			i, err := strconv.ParseInt(v, 10, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing finding_criteria criterion %s (%s): %w", key, v, err)
+			}
+
+			*field = aws.Int64(i)
+		}
+
+		apiObject.Criterion[criterion["field"].(string)] = properties
+	}
+
+	return apiObject, nil
+}
+
+func flattenMacie2FindingCriteria(apiObject *macie2.FindingCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var criteria []interface{}
+
+	for field, properties := range apiObject.Criterion {
+		if properties == nil {
+			continue
+		}
+
+		criterion := map[string]interface{}{
+			"eq":             aws.StringValueSlice(properties.Eq),
+			"eq_exact_match": aws.StringValueSlice(properties.EqExactMatch),
+			"field":          field,
+			"neq":            aws.StringValueSlice(properties.Neq),
+		}
+
+		for key, v := range map[string]*int64{
+			"gt":  properties.Gt,
+			"gte": properties.Gte,
+			"lt":  properties.Lt,
+			"lte": properties.Lte,
+		} {
+			if v != nil {
+				criterion[key] = strconv.FormatInt(aws.Int64Value(v), 10)
+			}
+		}
+
+		criteria = append(criteria, criterion)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"criterion": criteria,
+		},
+	}
+}
@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMacie2Member() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2MemberCreate,
+		Read:   resourceAwsMacie2MemberRead,
+		Update: resourceAwsMacie2MemberUpdate,
+		Delete: resourceAwsMacie2MemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invitation_disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"invitation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"invited_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2MemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := d.Get("account_id").(string)
+	input := &macie2.CreateMemberInput{
+		Account: &macie2.AccountDetail{
+			AccountId: aws.String(accountID),
+			Email:     aws.String(d.Get("email").(string)),
+		},
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Member: %s", input)
+	_, err := conn.CreateMember(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Member (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if d.Get("invite").(bool) {
+		if err := macie2InviteMember(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMacie2MemberRead(d, meta)
+}
+
+func resourceAwsMacie2MemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.MemberByAccountID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Member (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", output.AccountId)
+	d.Set("arn", output.Arn)
+	d.Set("email", output.Email)
+	d.Set("master_account_id", output.MasterAccountId)
+	d.Set("relationship_status", output.RelationshipStatus)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	if v := output.InvitedAt; v != nil {
+		d.Set("invited_at", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("invited_at", nil)
+	}
+
+	switch aws.StringValue(output.RelationshipStatus) {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusInvited, macie2.RelationshipStatusPaused,
+		macie2.RelationshipStatusEmailVerificationInProgress:
+		d.Set("invite", true)
+	default:
+		d.Set("invite", false)
+	}
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2MemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := macie2InviteMember(conn, d); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Disassociating Macie Member: %s", d.Id())
+			_, err := conn.DisassociateMember(&macie2.DisassociateMemberInput{
+				Id: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error disassociating Macie Member (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Member (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2MemberRead(d, meta)
+}
+
+func resourceAwsMacie2MemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Disassociating Macie Member: %s", d.Id())
+	_, err := conn.DisassociateMember(&macie2.DisassociateMemberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Macie Member (%s): %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Macie Member: %s", d.Id())
+	_, err = conn.DeleteMember(&macie2.DeleteMemberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Member (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func macie2InviteMember(conn *macie2.Macie2, d *schema.ResourceData) error {
+	input := &macie2.CreateInvitationsInput{
+		AccountIds:               aws.StringSlice([]string{d.Id()}),
+		DisableEmailNotification: aws.Bool(d.Get("invitation_disable_email_notification").(bool)),
+	}
+
+	if v, ok := d.GetOk("invitation_message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Inviting Macie Member: %s", input)
+	output, err := conn.CreateInvitations(input)
+
+	if err != nil {
+		return fmt.Errorf("error inviting Macie Member (%s): %w", d.Id(), err)
+	}
+
+	for _, unprocessedAccount := range output.UnprocessedAccounts {
+		if unprocessedAccount == nil {
+			continue
+		}
+
+		return fmt.Errorf("error inviting Macie Member (%s): %s: %s", d.Id(), aws.StringValue(unprocessedAccount.ErrorCode), aws.StringValue(unprocessedAccount.ErrorMessage))
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsMacie2Member_basic(t *testing.T) {
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigBasic(accountID, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					testAccCheckResourceAttrAccountID(resourceName, "master_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_disappears(t *testing.T) {
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigBasic(accountID, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Member(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_Invite(t *testing.T) {
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+	accountID, email := testAccAWSMacie2MemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigInvite(accountID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
+				),
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigInvite(accountID, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					testAccCheckResourceAttrRfc3339(resourceName, "invited_at"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
+				),
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigInvite(accountID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusRemoved),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"invitation_disable_email_notification",
+					"invitation_message",
+				},
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_Tags(t *testing.T) {
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+	accountID := "111111111111"
+	email := "required@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigTags1(accountID, email, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigTags2(accountID, email, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigTags1(accountID, email, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2MemberExists(n string, v *macie2.GetMemberOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Member ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := finder.MemberByAccountID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsMacie2MemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_member" {
+			continue
+		}
+
+		_, err := finder.MemberByAccountID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Member %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2MemberConfigBasic(accountID, email string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email)
+}
+
+func testAccAwsMacie2MemberConfigInvite(accountID, email string, invite bool) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id                            = %[1]q
+  email                                 = %[2]q
+  invite                                = %[3]t
+  invitation_message                    = "This is a message of the invitation"
+  invitation_disable_email_notification = true
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, invite)
+}
+
+func testAccAwsMacie2MemberConfigTags1(accountID, email, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2MemberConfigTags2(accountID, email, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMacie2OrganizationAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2OrganizationAdminAccountCreate,
+		Read:   resourceAwsMacie2OrganizationAdminAccountRead,
+		Delete: resourceAwsMacie2OrganizationAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2OrganizationAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	adminAccountID := d.Get("admin_account_id").(string)
+	input := &macie2.EnableOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(adminAccountID),
+		ClientToken:    aws.String(resource.UniqueId()),
+	}
+
+	log.Printf("[DEBUG] Enabling Macie Organization Admin Account: %s", input)
+	_, err := conn.EnableOrganizationAdminAccount(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Macie Organization Admin Account (%s): %w", adminAccountID, err)
+	}
+
+	d.SetId(adminAccountID)
+
+	return resourceAwsMacie2OrganizationAdminAccountRead(d, meta)
+}
+
+func resourceAwsMacie2OrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	adminAccount, err := finder.OrganizationAdminAccountByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Organization Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Organization Admin Account (%s): %w", d.Id(), err)
+	}
+
+	d.Set("admin_account_id", adminAccount.AccountId)
+
+	return nil
+}
+
+func resourceAwsMacie2OrganizationAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Disabling Macie Organization Admin Account: %s", d.Id())
+	_, err := conn.DisableOrganizationAdminAccount(&macie2.DisableOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Macie Organization Admin Account (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsMacie2OrganizationAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_macie2_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(macie2.EndpointsID, t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2OrganizationAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2OrganizationAdminAccountConfigSelf(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2OrganizationAdminAccountExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "admin_account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2OrganizationAdminAccount_disappears(t *testing.T) {
+	resourceName := "aws_macie2_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(macie2.EndpointsID, t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2OrganizationAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2OrganizationAdminAccountConfigSelf(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2OrganizationAdminAccountExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2OrganizationAdminAccount(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2OrganizationAdminAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Organization Admin Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := finder.OrganizationAdminAccountByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAwsMacie2OrganizationAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_organization_admin_account" {
+			continue
+		}
+
+		_, err := finder.OrganizationAdminAccountByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Organization Admin Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsMacie2OrganizationAdminAccountConfigSelf() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["macie.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_organization_admin_account" "test" {
+  admin_account_id = data.aws_caller_identity.current.account_id
+
+  depends_on = [aws_macie2_account.test, aws_organizations_organization.test]
+}
+`
+}
@@ -0,0 +1,628 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mwaa/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mwaa/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMwaaEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMwaaEnvironmentCreate,
+		Read:   resourceAwsMwaaEnvironmentRead,
+		Update: resourceAwsMwaaEnvironmentUpdate,
+		Delete: resourceAwsMwaaEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"airflow_configuration_options": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"airflow_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dag_s3_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"mw1.small", "mw1.medium", "mw1.large"}, false),
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"kms_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"last_updated": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"error_message": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"logging_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dag_processing_logs": mwaaEnvironmentModuleLoggingConfigurationSchema(),
+						"scheduler_logs":      mwaaEnvironmentModuleLoggingConfigurationSchema(),
+						"task_logs":           mwaaEnvironmentModuleLoggingConfigurationSchema(),
+						"webserver_logs":      mwaaEnvironmentModuleLoggingConfigurationSchema(),
+						"worker_logs":         mwaaEnvironmentModuleLoggingConfigurationSchema(),
+					},
+				},
+			},
+			"max_workers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 80),
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"plugins_s3_object_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"plugins_s3_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"requirements_s3_object_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"requirements_s3_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_bucket_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"webserver_access_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mwaa.WebserverAccessMode_Values(), false),
+			},
+			"webserver_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weekly_maintenance_window_start": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func mwaaEnvironmentModuleLoggingConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cloud_watch_log_group_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"log_level": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(mwaa.LoggingLevel_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMwaaEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mwaaconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mwaa.CreateEnvironmentInput{
+		DagS3Path:            aws.String(d.Get("dag_s3_path").(string)),
+		ExecutionRoleArn:     aws.String(d.Get("execution_role_arn").(string)),
+		Name:                 aws.String(name),
+		NetworkConfiguration: expandMwaaEnvironmentNetworkConfigurationCreate(d.Get("network_configuration").([]interface{})),
+		SourceBucketArn:      aws.String(d.Get("source_bucket_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("airflow_configuration_options"); ok {
+		input.AirflowConfigurationOptions = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("airflow_version"); ok {
+		input.AirflowVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("environment_class"); ok {
+		input.EnvironmentClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KmsKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging_configuration"); ok {
+		input.LoggingConfiguration = expandMwaaEnvironmentLoggingConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("max_workers"); ok {
+		input.MaxWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("plugins_s3_object_version"); ok {
+		input.PluginsS3ObjectVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("plugins_s3_path"); ok {
+		input.PluginsS3Path = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("requirements_s3_object_version"); ok {
+		input.RequirementsS3ObjectVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("requirements_s3_path"); ok {
+		input.RequirementsS3Path = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("webserver_access_mode"); ok {
+		input.WebserverAccessMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("weekly_maintenance_window_start"); ok {
+		input.WeeklyMaintenanceWindowStart = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MwaaTags()
+	}
+
+	log.Printf("[INFO] Creating MWAA Environment: %s", input)
+	_, err := conn.CreateEnvironment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MWAA Environment (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.EnvironmentCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MWAA Environment (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsMwaaEnvironmentRead(d, meta)
+}
+
+func resourceAwsMwaaEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mwaaconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	environment, err := finder.EnvironmentByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MWAA Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("airflow_configuration_options", aws.StringValueMap(environment.AirflowConfigurationOptions))
+	d.Set("airflow_version", environment.AirflowVersion)
+	d.Set("arn", environment.Arn)
+	d.Set("created_at", aws.TimeValue(environment.CreatedAt).String())
+	d.Set("dag_s3_path", environment.DagS3Path)
+	d.Set("environment_class", environment.EnvironmentClass)
+	d.Set("execution_role_arn", environment.ExecutionRoleArn)
+	d.Set("kms_key", environment.KmsKey)
+
+	if err := d.Set("last_updated", flattenMwaaEnvironmentLastUpdate(environment.LastUpdate)); err != nil {
+		return fmt.Errorf("error setting last_updated: %w", err)
+	}
+
+	if err := d.Set("logging_configuration", flattenMwaaEnvironmentLoggingConfiguration(environment.LoggingConfiguration)); err != nil {
+		return fmt.Errorf("error setting logging_configuration: %w", err)
+	}
+
+	d.Set("max_workers", environment.MaxWorkers)
+	d.Set("name", environment.Name)
+
+	if err := d.Set("network_configuration", flattenMwaaEnvironmentNetworkConfiguration(environment.NetworkConfiguration)); err != nil {
+		return fmt.Errorf("error setting network_configuration: %w", err)
+	}
+
+	d.Set("plugins_s3_object_version", environment.PluginsS3ObjectVersion)
+	d.Set("plugins_s3_path", environment.PluginsS3Path)
+	d.Set("requirements_s3_object_version", environment.RequirementsS3ObjectVersion)
+	d.Set("requirements_s3_path", environment.RequirementsS3Path)
+	d.Set("service_role_arn", environment.ServiceRoleArn)
+	d.Set("source_bucket_arn", environment.SourceBucketArn)
+	d.Set("status", environment.Status)
+	d.Set("webserver_access_mode", environment.WebserverAccessMode)
+	d.Set("webserver_url", environment.WebserverUrl)
+	d.Set("weekly_maintenance_window_start", environment.WeeklyMaintenanceWindowStart)
+
+	tags := keyvaluetags.MwaaKeyValueTags(environment.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMwaaEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mwaaconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mwaa.UpdateEnvironmentInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("airflow_configuration_options") {
+			options := stringMapToPointers(d.Get("airflow_configuration_options").(map[string]interface{}))
+
+			// An empty map removes all options.
+			if options == nil {
+				options = map[string]*string{}
+			}
+
+			input.AirflowConfigurationOptions = options
+		}
+
+		if d.HasChange("airflow_version") {
+			input.AirflowVersion = aws.String(d.Get("airflow_version").(string))
+		}
+
+		if d.HasChange("dag_s3_path") {
+			input.DagS3Path = aws.String(d.Get("dag_s3_path").(string))
+		}
+
+		if d.HasChange("environment_class") {
+			input.EnvironmentClass = aws.String(d.Get("environment_class").(string))
+		}
+
+		if d.HasChange("execution_role_arn") {
+			input.ExecutionRoleArn = aws.String(d.Get("execution_role_arn").(string))
+		}
+
+		if d.HasChange("logging_configuration") {
+			input.LoggingConfiguration = expandMwaaEnvironmentLoggingConfiguration(d.Get("logging_configuration").([]interface{}))
+		}
+
+		if d.HasChange("max_workers") {
+			input.MaxWorkers = aws.Int64(int64(d.Get("max_workers").(int)))
+		}
+
+		if d.HasChange("network_configuration") {
+			input.NetworkConfiguration = expandMwaaEnvironmentNetworkConfigurationUpdate(d.Get("network_configuration").([]interface{}))
+		}
+
+		if d.HasChange("plugins_s3_object_version") {
+			input.PluginsS3ObjectVersion = aws.String(d.Get("plugins_s3_object_version").(string))
+		}
+
+		if d.HasChange("plugins_s3_path") {
+			input.PluginsS3Path = aws.String(d.Get("plugins_s3_path").(string))
+		}
+
+		if d.HasChange("requirements_s3_object_version") {
+			input.RequirementsS3ObjectVersion = aws.String(d.Get("requirements_s3_object_version").(string))
+		}
+
+		if d.HasChange("requirements_s3_path") {
+			input.RequirementsS3Path = aws.String(d.Get("requirements_s3_path").(string))
+		}
+
+		if d.HasChange("source_bucket_arn") {
+			input.SourceBucketArn = aws.String(d.Get("source_bucket_arn").(string))
+		}
+
+		if d.HasChange("webserver_access_mode") {
+			input.WebserverAccessMode = aws.String(d.Get("webserver_access_mode").(string))
+		}
+
+		if d.HasChange("weekly_maintenance_window_start") {
+			input.WeeklyMaintenanceWindowStart = aws.String(d.Get("weekly_maintenance_window_start").(string))
+		}
+
+		log.Printf("[INFO] Updating MWAA Environment: %s", input)
+		_, err := conn.UpdateEnvironment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MWAA Environment (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.EnvironmentUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MWAA Environment (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MwaaUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MWAA Environment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMwaaEnvironmentRead(d, meta)
+}
+
+func resourceAwsMwaaEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mwaaconn
+
+	log.Printf("[INFO] Deleting MWAA Environment: %s", d.Id())
+	_, err := conn.DeleteEnvironment(&mwaa.DeleteEnvironmentInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mwaa.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MWAA Environment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.EnvironmentDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MWAA Environment (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMwaaEnvironmentLoggingConfiguration(l []interface{}) *mwaa.LoggingConfigurationInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &mwaa.LoggingConfigurationInput{
+		DagProcessingLogs: expandMwaaEnvironmentModuleLoggingConfiguration(tfMap["dag_processing_logs"].([]interface{})),
+		SchedulerLogs:     expandMwaaEnvironmentModuleLoggingConfiguration(tfMap["scheduler_logs"].([]interface{})),
+		TaskLogs:          expandMwaaEnvironmentModuleLoggingConfiguration(tfMap["task_logs"].([]interface{})),
+		WebserverLogs:     expandMwaaEnvironmentModuleLoggingConfiguration(tfMap["webserver_logs"].([]interface{})),
+		WorkerLogs:        expandMwaaEnvironmentModuleLoggingConfiguration(tfMap["worker_logs"].([]interface{})),
+	}
+}
+
+func expandMwaaEnvironmentModuleLoggingConfiguration(l []interface{}) *mwaa.ModuleLoggingConfigurationInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	input := &mwaa.ModuleLoggingConfigurationInput{
+		Enabled:  aws.Bool(tfMap["enabled"].(bool)),
+		LogLevel: aws.String(mwaa.LoggingLevelInfo),
+	}
+
+	if v, ok := tfMap["log_level"].(string); ok && v != "" {
+		input.LogLevel = aws.String(v)
+	}
+
+	return input
+}
+
+func expandMwaaEnvironmentNetworkConfigurationCreate(l []interface{}) *mwaa.NetworkConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &mwaa.NetworkConfiguration{
+		SecurityGroupIds: expandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+		SubnetIds:        expandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+	}
+}
+
+func expandMwaaEnvironmentNetworkConfigurationUpdate(l []interface{}) *mwaa.UpdateNetworkConfigurationInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &mwaa.UpdateNetworkConfigurationInput{
+		SecurityGroupIds: expandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+	}
+}
+
+func flattenMwaaEnvironmentLastUpdate(lastUpdate *mwaa.LastUpdate) []interface{} {
+	if lastUpdate == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"status": aws.StringValue(lastUpdate.Status),
+	}
+
+	if lastUpdate.CreatedAt != nil {
+		tfMap["created_at"] = aws.TimeValue(lastUpdate.CreatedAt).String()
+	}
+
+	if lastUpdate.Error != nil {
+		tfMap["error"] = []interface{}{
+			map[string]interface{}{
+				"error_code":    aws.StringValue(lastUpdate.Error.ErrorCode),
+				"error_message": aws.StringValue(lastUpdate.Error.ErrorMessage),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMwaaEnvironmentLoggingConfiguration(loggingConfiguration *mwaa.LoggingConfiguration) []interface{} {
+	if loggingConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dag_processing_logs": flattenMwaaEnvironmentModuleLoggingConfiguration(loggingConfiguration.DagProcessingLogs),
+		"scheduler_logs":      flattenMwaaEnvironmentModuleLoggingConfiguration(loggingConfiguration.SchedulerLogs),
+		"task_logs":           flattenMwaaEnvironmentModuleLoggingConfiguration(loggingConfiguration.TaskLogs),
+		"webserver_logs":      flattenMwaaEnvironmentModuleLoggingConfiguration(loggingConfiguration.WebserverLogs),
+		"worker_logs":         flattenMwaaEnvironmentModuleLoggingConfiguration(loggingConfiguration.WorkerLogs),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMwaaEnvironmentModuleLoggingConfiguration(moduleLoggingConfiguration *mwaa.ModuleLoggingConfiguration) []interface{} {
+	if moduleLoggingConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cloud_watch_log_group_arn": aws.StringValue(moduleLoggingConfiguration.CloudWatchLogGroupArn),
+		"enabled":                   aws.BoolValue(moduleLoggingConfiguration.Enabled),
+		"log_level":                 aws.StringValue(moduleLoggingConfiguration.LogLevel),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMwaaEnvironmentNetworkConfiguration(networkConfiguration *mwaa.NetworkConfiguration) []interface{} {
+	if networkConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": flattenStringSet(networkConfiguration.SecurityGroupIds),
+		"subnet_ids":         flattenStringSet(networkConfiguration.SubnetIds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,480 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mwaa/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
+		Name: "aws_mwaa_environment",
+		F:    testSweepMwaaEnvironments,
+	})
+}
+
+func testSweepMwaaEnvironments(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).mwaaconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListEnvironmentsPages(&mwaa.ListEnvironmentsInput{}, func(page *mwaa.ListEnvironmentsOutput, lastPage bool) bool {
+		for _, name := range page.Environments {
+			r := resourceAwsMwaaEnvironment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(name))
+
+			log.Printf("[INFO] Deleting MWAA Environment: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MWAA Environment sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MWAA Environments: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMwaaEnvironment_basic(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "airflow", fmt.Sprintf("environment/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "airflow_version"),
+					resource.TestCheckResourceAttr(resourceName, "dag_s3_path", "dags/"),
+					resource.TestCheckResourceAttr(resourceName, "environment_class", "mw1.small"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.task_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.task_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.task_logs.0.log_level", mwaa.LoggingLevelInfo),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "10"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", mwaa.EnvironmentStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "webserver_access_mode", mwaa.WebserverAccessModePrivateOnly),
+					resource.TestCheckResourceAttrSet(resourceName, "webserver_url"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_disappears(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMwaaEnvironment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_full(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigFull(rName, 5, mwaa.LoggingLevelInfo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.core.default_task_retries", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_class", "mw1.medium"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.log_level", mwaa.LoggingLevelInfo),
+					resource.TestCheckResourceAttrSet(resourceName, "logging_configuration.0.dag_processing_logs.0.cloud_watch_log_group_arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.scheduler_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.webserver_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "5"),
+					resource.TestCheckResourceAttr(resourceName, "plugins_s3_path", "plugins.zip"),
+					resource.TestCheckResourceAttrPair(resourceName, "plugins_s3_object_version", "aws_s3_bucket_object.plugins", "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "requirements_s3_path", "requirements.txt"),
+					resource.TestCheckResourceAttrPair(resourceName, "requirements_s3_object_version", "aws_s3_bucket_object.requirements", "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "webserver_access_mode", mwaa.WebserverAccessModePublicOnly),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_window_start", "SAT:03:00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigFull(rName, 10, mwaa.LoggingLevelWarning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.0.status", mwaa.UpdateStatusSuccess),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.log_level", mwaa.LoggingLevelWarning),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMwaaEnvironmentExists(resourceName string, environment *mwaa.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MWAA Environment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+
+		output, err := finder.EnvironmentByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*environment = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMwaaEnvironmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mwaa_environment" {
+			continue
+		}
+
+		_, err := finder.EnvironmentByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MWAA Environment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMwaaEnvironmentConfigBase(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    from_port = 0
+    to_port   = 0
+    protocol  = "-1"
+    self      = true
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_object" "dags" {
+  bucket       = aws_s3_bucket.test.id
+  acl          = "private"
+  key          = "dags/"
+  content_type = "application/x-directory"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "airflow.${data.aws_partition.current.dns_suffix}",
+          "airflow-env.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "airflow:PublishMetrics",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": "logs:*",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "cloudwatch:PutMetricData",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "sqs:ChangeMessageVisibility",
+        "sqs:DeleteMessage",
+        "sqs:GetQueueAttributes",
+        "sqs:GetQueueUrl",
+        "sqs:ReceiveMessage",
+        "sqs:SendMessage"
+      ],
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:airflow-celery-*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "kms:Decrypt",
+        "kms:DescribeKey",
+        "kms:Encrypt",
+        "kms:GenerateDataKey*"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+`, rName))
+}
+
+func testAccAWSMwaaEnvironmentConfigBasic(rName string) string {
+	return composeConfig(testAccAWSMwaaEnvironmentConfigBase(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.test[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccAWSMwaaEnvironmentConfigFull(rName string, maxWorkers int, logLevel string) string {
+	return composeConfig(testAccAWSMwaaEnvironmentConfigBase(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket_object" "plugins" {
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "plugins.zip"
+  content = ""
+}
+
+resource "aws_s3_bucket_object" "requirements" {
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "requirements.txt"
+  content = ""
+}
+
+resource "aws_mwaa_environment" "test" {
+  airflow_configuration_options = {
+    "core.default_task_retries" = 1
+  }
+
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  environment_class  = "mw1.medium"
+  execution_role_arn = aws_iam_role.test.arn
+  kms_key            = aws_kms_key.test.arn
+
+  logging_configuration {
+    dag_processing_logs {
+      enabled   = true
+      log_level = %[3]q
+    }
+
+    scheduler_logs {
+      enabled   = true
+      log_level = %[3]q
+    }
+
+    task_logs {
+      enabled   = true
+      log_level = %[3]q
+    }
+
+    webserver_logs {
+      enabled   = true
+      log_level = %[3]q
+    }
+
+    worker_logs {
+      enabled   = true
+      log_level = %[3]q
+    }
+  }
+
+  max_workers = %[2]d
+  name        = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.test[*].id
+  }
+
+  plugins_s3_object_version       = aws_s3_bucket_object.plugins.version_id
+  plugins_s3_path                 = aws_s3_bucket_object.plugins.key
+  requirements_s3_object_version  = aws_s3_bucket_object.requirements.version_id
+  requirements_s3_path            = aws_s3_bucket_object.requirements.key
+  source_bucket_arn               = aws_s3_bucket.test.arn
+  webserver_access_mode           = "PUBLIC_ONLY"
+  weekly_maintenance_window_start = "SAT:03:00"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, maxWorkers, logLevel))
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsNetworkManagerCustomerGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerCustomerGatewayAssociationCreate,
+		Read:   resourceAwsNetworkManagerCustomerGatewayAssociationRead,
+		Delete: resourceAwsNetworkManagerCustomerGatewayAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"customer_gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerCustomerGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	customerGatewayARN := d.Get("customer_gateway_arn").(string)
+	id := tfnetworkmanager.CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN)
+	input := &networkmanager.AssociateCustomerGatewayInput{
+		CustomerGatewayArn: aws.String(customerGatewayARN),
+		DeviceId:           aws.String(d.Get("device_id").(string)),
+		GlobalNetworkId:    aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("link_id"); ok {
+		input.LinkId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Customer Gateway Association: %s", input)
+	_, err := conn.AssociateCustomerGateway(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Customer Gateway Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.CustomerGatewayAssociationCreated(conn, globalNetworkID, customerGatewayARN); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Customer Gateway Association (%s) to create: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerCustomerGatewayAssociationRead(d, meta)
+}
+
+func resourceAwsNetworkManagerCustomerGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, customerGatewayARN, err := tfnetworkmanager.CustomerGatewayAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.CustomerGatewayAssociationByTwoPartKey(conn, globalNetworkID, customerGatewayARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Customer Gateway Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Customer Gateway Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("customer_gateway_arn", output.CustomerGatewayArn)
+	d.Set("device_id", output.DeviceId)
+	d.Set("global_network_id", output.GlobalNetworkId)
+	d.Set("link_id", output.LinkId)
+
+	return nil
+}
+
+func resourceAwsNetworkManagerCustomerGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, customerGatewayARN, err := tfnetworkmanager.CustomerGatewayAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Customer Gateway Association: %s", d.Id())
+	_, err = conn.DisassociateCustomerGateway(&networkmanager.DisassociateCustomerGatewayInput{
+		CustomerGatewayArn: aws.String(customerGatewayARN),
+		GlobalNetworkId:    aws.String(globalNetworkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Customer Gateway Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.CustomerGatewayAssociationDeleted(conn, globalNetworkID, customerGatewayARN); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Customer Gateway Association (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSNetworkManagerCustomerGatewayAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_customer_gateway_association.test"
+	customerGatewayResourceName := "aws_customer_gateway.test"
+	deviceResourceName := "aws_networkmanager_device.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerCustomerGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerCustomerGatewayAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerCustomerGatewayAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "customer_gateway_arn", customerGatewayResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "device_id", deviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "link_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerCustomerGatewayAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_customer_gateway_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerCustomerGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerCustomerGatewayAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerCustomerGatewayAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerCustomerGatewayAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerCustomerGatewayAssociation_LinkId(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_customer_gateway_association.test"
+	linkResourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerCustomerGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerCustomerGatewayAssociationConfigLinkId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerCustomerGatewayAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "link_id", linkResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerCustomerGatewayAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Customer Gateway Association ID is set")
+		}
+
+		globalNetworkID, customerGatewayARN, err := tfnetworkmanager.CustomerGatewayAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		_, err = finder.CustomerGatewayAssociationByTwoPartKey(conn, globalNetworkID, customerGatewayARN)
+
+		return err
+	}
+}
+
+func testAccCheckAWSNetworkManagerCustomerGatewayAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_customer_gateway_association" {
+			continue
+		}
+
+		globalNetworkID, customerGatewayARN, err := tfnetworkmanager.CustomerGatewayAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.CustomerGatewayAssociationByTwoPartKey(conn, globalNetworkID, customerGatewayARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Customer Gateway Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkManagerCustomerGatewayAssociationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = 65000
+  ip_address = "172.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id = aws_customer_gateway.test.id
+  transit_gateway_id  = aws_ec2_transit_gateway.test.id
+  type                = aws_customer_gateway.test.type
+  static_routes_only  = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_transit_gateway_registration" "test" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  transit_gateway_arn = aws_ec2_transit_gateway.test.arn
+
+  depends_on = [aws_vpn_connection.test]
+}
+`, rName)
+}
+
+func testAccAWSNetworkManagerCustomerGatewayAssociationConfig(rName string) string {
+	return composeConfig(
+		testAccAWSNetworkManagerCustomerGatewayAssociationConfigBase(rName),
+		`
+resource "aws_networkmanager_customer_gateway_association" "test" {
+  global_network_id    = aws_networkmanager_global_network.test.id
+  customer_gateway_arn = aws_customer_gateway.test.arn
+  device_id            = aws_networkmanager_device.test.id
+
+  depends_on = [aws_networkmanager_transit_gateway_registration.test]
+}
+`)
+}
+
+func testAccAWSNetworkManagerCustomerGatewayAssociationConfigLinkId(rName string) string {
+	return composeConfig(
+		testAccAWSNetworkManagerCustomerGatewayAssociationConfigBase(rName),
+		`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+
+resource "aws_networkmanager_link_association" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  link_id           = aws_networkmanager_link.test.id
+  device_id         = aws_networkmanager_device.test.id
+}
+
+resource "aws_networkmanager_customer_gateway_association" "test" {
+  global_network_id    = aws_networkmanager_global_network.test.id
+  customer_gateway_arn = aws_customer_gateway.test.arn
+  device_id            = aws_networkmanager_device.test.id
+  link_id              = aws_networkmanager_link.test.id
+
+  depends_on = [
+    aws_networkmanager_link_association.test,
+    aws_networkmanager_transit_gateway_registration.test,
+  ]
+}
+`)
+}
@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsNetworkManagerDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerDeviceCreate,
+		Read:   resourceAwsNetworkManagerDeviceRead,
+		Update: resourceAwsNetworkManagerDeviceUpdate,
+		Delete: resourceAwsNetworkManagerDeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkManagerGlobalNetworkChildImport,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": networkManagerLocationSchema(),
+			"model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"serial_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.CreateDeviceInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("aws_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AWSLocation = expandNetworkManagerAWSLocation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Location = expandNetworkManagerLocation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("model"); ok {
+		input.Model = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("serial_number"); ok {
+		input.SerialNumber = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("site_id"); ok {
+		input.SiteId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().NetworkmanagerTags()
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vendor"); ok {
+		input.Vendor = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Device: %s", input)
+	output, err := conn.CreateDevice(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Device: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Device.DeviceId))
+
+	if _, err := waiter.DeviceCreated(conn, globalNetworkID, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Device (%s) to create: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerDeviceRead(d, meta)
+}
+
+func resourceAwsNetworkManagerDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	device, err := finder.DeviceByTwoPartKey(conn, d.Get("global_network_id").(string), d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Device (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", device.DeviceArn)
+
+	if err := d.Set("aws_location", flattenNetworkManagerAWSLocation(device.AWSLocation)); err != nil {
+		return fmt.Errorf("error setting aws_location: %w", err)
+	}
+
+	d.Set("description", device.Description)
+	d.Set("global_network_id", device.GlobalNetworkId)
+
+	if err := d.Set("location", flattenNetworkManagerLocation(device.Location)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
+	d.Set("model", device.Model)
+	d.Set("serial_number", device.SerialNumber)
+	d.Set("site_id", device.SiteId)
+	d.Set("type", device.Type)
+	d.Set("vendor", device.Vendor)
+
+	tags := keyvaluetags.NetworkmanagerKeyValueTags(device.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		globalNetworkID := d.Get("global_network_id").(string)
+		input := &networkmanager.UpdateDeviceInput{
+			Description:     aws.String(d.Get("description").(string)),
+			DeviceId:        aws.String(d.Id()),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			Model:           aws.String(d.Get("model").(string)),
+			SerialNumber:    aws.String(d.Get("serial_number").(string)),
+			SiteId:          aws.String(d.Get("site_id").(string)),
+			Type:            aws.String(d.Get("type").(string)),
+			Vendor:          aws.String(d.Get("vendor").(string)),
+		}
+
+		if v, ok := d.GetOk("aws_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AWSLocation = expandNetworkManagerAWSLocation(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Location = expandNetworkManagerLocation(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Device: %s", input)
+		_, err := conn.UpdateDevice(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Network Manager Device (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DeviceUpdated(conn, globalNetworkID, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Network Manager Device (%s) to update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.NetworkmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Network Manager Device (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNetworkManagerDeviceRead(d, meta)
+}
+
+func resourceAwsNetworkManagerDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Device: %s", d.Id())
+	_, err := conn.DeleteDevice(&networkmanager.DeleteDeviceInput{
+		DeviceId:        aws.String(d.Id()),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Device (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DeviceDeleted(conn, globalNetworkID, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Device (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerAWSLocation(tfMap map[string]interface{}) *networkmanager.AWSLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.AWSLocation{}
+
+	if v, ok := tfMap["subnet_arn"].(string); ok && v != "" {
+		apiObject.SubnetArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["zone"].(string); ok && v != "" {
+		apiObject.Zone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenNetworkManagerAWSLocation(apiObject *networkmanager.AWSLocation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if aws.StringValue(apiObject.SubnetArn) == "" && aws.StringValue(apiObject.Zone) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"subnet_arn": aws.StringValue(apiObject.SubnetArn),
+		"zone":       aws.StringValue(apiObject.Zone),
+	}
+
+	return []interface{}{tfMap}
+}
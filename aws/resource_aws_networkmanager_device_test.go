@@ -0,0 +1,411 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+		Name: "aws_networkmanager_device",
+		F:    testSweepNetworkManagerDevices,
+	})
+}
+
+func testSweepNetworkManagerDevices(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).networkmanagerconn
+	var sweeperErrs *multierror.Error
+
+	globalNetworkIDs, err := testSweepNetworkManagerGlobalNetworkIDs(conn)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Device sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Global Networks: %w", err)
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		input := &networkmanager.GetDevicesInput{
+			GlobalNetworkId: aws.String(globalNetworkID),
+		}
+
+		err := conn.GetDevicesPages(input, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+			for _, device := range page.Devices {
+				r := resourceAwsNetworkManagerDevice()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(device.DeviceId))
+				d.Set("global_network_id", globalNetworkID)
+
+				log.Printf("[INFO] Deleting Network Manager Device: %s", d.Id())
+				if err := r.Delete(d, client); err != nil {
+					log.Printf("[ERROR] %s", err)
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Devices (%s): %w", globalNetworkID, err))
+		}
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSNetworkManagerDevice_basic(t *testing.T) {
+	var v networkmanager.Device
+	resourceName := "aws_networkmanager_device.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`device/global-network-.+/device-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "aws_location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "model", ""),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", ""),
+					resource.TestCheckResourceAttr(resourceName, "site_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+					resource.TestCheckResourceAttr(resourceName, "vendor", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_disappears(t *testing.T) {
+	var v networkmanager.Device
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerDevice(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_allAttributes(t *testing.T) {
+	var v networkmanager.Device
+	resourceName := "aws_networkmanager_device.test"
+	site1ResourceName := "aws_networkmanager_site.test1"
+	site2ResourceName := "aws_networkmanager_site.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigAllAttributes("test1", "description1", "model1", "sn1", "type1", "vendor1", "Stuart, FL", "27.198", "-80.253"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.address", "Stuart, FL"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.latitude", "27.198"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.longitude", "-80.253"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model1"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "sn1"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", site1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigAllAttributes("test2", "description2", "model2", "sn2", "type2", "vendor2", "Brisbane, QLD", "-27.470", "153.026"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.address", "Brisbane, QLD"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.latitude", "-27.470"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.longitude", "153.026"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model2"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "sn2"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", site2ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_AwsLocation(t *testing.T) {
+	var v networkmanager.Device
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_device.test"
+	subnetResourceName := "aws_subnet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigAwsLocation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aws_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "aws_location.0.subnet_arn", subnetResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "aws_location.0.zone", subnetResourceName, "availability_zone"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_Tags(t *testing.T) {
+	var v networkmanager.Device
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerDeviceExists(n string, v *networkmanager.Device) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Device ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		output, err := finder.DeviceByTwoPartKey(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkManagerDeviceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_device" {
+			continue
+		}
+
+		_, err := finder.DeviceByTwoPartKey(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Device %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkManagerDeviceConfigBasic() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccAWSNetworkManagerDeviceConfigAllAttributes(siteName, description, model, serialNumber, deviceType, vendor, address, latitude, longitude string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test1" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_site" "test2" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  description       = %[2]q
+  model             = %[3]q
+  serial_number     = %[4]q
+  site_id           = aws_networkmanager_site.%[1]s.id
+  type              = %[5]q
+  vendor            = %[6]q
+
+  location {
+    address   = %[7]q
+    latitude  = %[8]q
+    longitude = %[9]q
+  }
+}
+`, siteName, description, model, serialNumber, deviceType, vendor, address, latitude, longitude)
+}
+
+func testAccAWSNetworkManagerDeviceConfigAwsLocation(rName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  aws_location {
+    subnet_arn = aws_subnet.test.arn
+    zone       = aws_subnet.test.availability_zone
+  }
+}
+`, rName))
+}
+
+func testAccAWSNetworkManagerDeviceConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSNetworkManagerDeviceConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+		Name: "aws_networkmanager_global_network",
+		F:    testSweepNetworkManagerGlobalNetworks,
+		Dependencies: []string{
+			"aws_networkmanager_site",
+			"aws_networkmanager_transit_gateway_registration",
+		},
+	})
+}
+
+func testSweepNetworkManagerGlobalNetworks(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).networkmanagerconn
+	input := &networkmanager.DescribeGlobalNetworksInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeGlobalNetworksPages(input, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		for _, globalNetwork := range page.GlobalNetworks {
+			r := resourceAwsNetworkManagerGlobalNetwork()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(globalNetwork.GlobalNetworkId))
+
+			log.Printf("[INFO] Deleting Network Manager Global Network: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Global Network sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Global Networks: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// testSweepNetworkManagerGlobalNetworkIDs returns the IDs of all global networks, for use by the child resource sweepers.
+func testSweepNetworkManagerGlobalNetworkIDs(conn *networkmanager.NetworkManager) ([]string, error) {
+	var globalNetworkIDs []string
+
+	err := conn.DescribeGlobalNetworksPages(&networkmanager.DescribeGlobalNetworksInput{}, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		for _, globalNetwork := range page.GlobalNetworks {
+			globalNetworkIDs = append(globalNetworkIDs, aws.StringValue(globalNetwork.GlobalNetworkId))
+		}
+
+		return !lastPage
+	})
+
+	return globalNetworkIDs, err
+}
+
+func TestAccAWSNetworkManagerGlobalNetwork_basic(t *testing.T) {
+	var v networkmanager.GlobalNetwork
+	resourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerGlobalNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerGlobalNetworkExists(resourceName, &v),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`global-network/global-network-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerGlobalNetwork_disappears(t *testing.T) {
+	var v networkmanager.GlobalNetwork
+	resourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerGlobalNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerGlobalNetworkExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerGlobalNetwork(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerGlobalNetwork_Description(t *testing.T) {
+	var v networkmanager.GlobalNetwork
+	resourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerGlobalNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkConfigDescription("description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerGlobalNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkConfigDescription("description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerGlobalNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerGlobalNetwork_Tags(t *testing.T) {
+	var v networkmanager.GlobalNetwork
+	resourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerGlobalNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerGlobalNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerGlobalNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerGlobalNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerGlobalNetworkExists(n string, v *networkmanager.GlobalNetwork) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Global Network ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		output, err := finder.GlobalNetworkByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkManagerGlobalNetworkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_global_network" {
+			continue
+		}
+
+		_, err := finder.GlobalNetworkByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Global Network %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSNetworkManagerImportStateIdFunc returns the ARN of a site, device or link, which is its import ID.
+func testAccAWSNetworkManagerImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
+
+func testAccAWSNetworkManagerGlobalNetworkConfigBasic() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+`
+}
+
+func testAccAWSNetworkManagerGlobalNetworkConfigDescription(description string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {
+  description = %[1]q
+}
+`, description)
+}
+
+func testAccAWSNetworkManagerGlobalNetworkConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSNetworkManagerGlobalNetworkConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSNetworkManagerLinkAssociation_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+	deviceResourceName := "aws_networkmanager_device.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	linkResourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "device_id", deviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "link_id", linkResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLinkAssociation_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerLinkAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerLinkAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Link Association ID is set")
+		}
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		_, err = finder.LinkAssociationByThreePartKey(conn, globalNetworkID, linkID, deviceID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSNetworkManagerLinkAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link_association" {
+			continue
+		}
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.LinkAssociationByThreePartKey(conn, globalNetworkID, linkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Link Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkManagerLinkAssociationConfig() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+
+resource "aws_networkmanager_link_association" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  link_id           = aws_networkmanager_link.test.id
+  device_id         = aws_networkmanager_device.test.id
+}
+`
+}
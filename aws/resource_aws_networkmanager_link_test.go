@@ -0,0 +1,352 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+		Name: "aws_networkmanager_link",
+		F:    testSweepNetworkManagerLinks,
+	})
+}
+
+func testSweepNetworkManagerLinks(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).networkmanagerconn
+	var sweeperErrs *multierror.Error
+
+	globalNetworkIDs, err := testSweepNetworkManagerGlobalNetworkIDs(conn)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Link sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Global Networks: %w", err)
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		input := &networkmanager.GetLinksInput{
+			GlobalNetworkId: aws.String(globalNetworkID),
+		}
+
+		err := conn.GetLinksPages(input, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+			for _, link := range page.Links {
+				r := resourceAwsNetworkManagerLink()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(link.LinkId))
+				d.Set("global_network_id", globalNetworkID)
+
+				log.Printf("[INFO] Deleting Network Manager Link: %s", d.Id())
+				if err := r.Delete(d, client); err != nil {
+					log.Printf("[ERROR] %s", err)
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Links (%s): %w", globalNetworkID, err))
+		}
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSNetworkManagerLink_basic(t *testing.T) {
+	var v networkmanager.Link
+	resourceName := "aws_networkmanager_link.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	siteResourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName, &v),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`link/global-network-.+/link-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "50"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "10"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_disappears(t *testing.T) {
+	var v networkmanager.Link
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerLink(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_allAttributes(t *testing.T) {
+	var v networkmanager.Link
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigAllAttributes("description1", "provider1", "type1", 10, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "10"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "20"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerLinkConfigAllAttributes("description2", "provider2", "type2", 100, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "100"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "50"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_Tags(t *testing.T) {
+	var v networkmanager.Link
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerLinkConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkManagerLinkConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerLinkExists(n string, v *networkmanager.Link) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Link ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		output, err := finder.LinkByTwoPartKey(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkManagerLinkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link" {
+			continue
+		}
+
+		_, err := finder.LinkByTwoPartKey(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Link %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkManagerLinkConfigBase() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccAWSNetworkManagerLinkConfigBasic() string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBase(),
+		`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+`)
+}
+
+func testAccAWSNetworkManagerLinkConfigAllAttributes(description, providerName, linkType string, downloadSpeed, uploadSpeed int) string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+  description       = %[1]q
+  provider_name     = %[2]q
+  type              = %[3]q
+
+  bandwidth {
+    download_speed = %[4]d
+    upload_speed   = %[5]d
+  }
+}
+`, description, providerName, linkType, downloadSpeed, uploadSpeed))
+}
+
+func testAccAWSNetworkManagerLinkConfigTags1(tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSNetworkManagerLinkConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
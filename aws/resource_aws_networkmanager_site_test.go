@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+		Name: "aws_networkmanager_site",
+		F:    testSweepNetworkManagerSites,
+		Dependencies: []string{
+			"aws_networkmanager_device",
+			"aws_networkmanager_link",
+		},
+	})
+}
+
+func testSweepNetworkManagerSites(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).networkmanagerconn
+	var sweeperErrs *multierror.Error
+
+	globalNetworkIDs, err := testSweepNetworkManagerGlobalNetworkIDs(conn)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Site sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Global Networks: %w", err)
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		input := &networkmanager.GetSitesInput{
+			GlobalNetworkId: aws.String(globalNetworkID),
+		}
+
+		err := conn.GetSitesPages(input, func(page *networkmanager.GetSitesOutput, lastPage bool) bool {
+			for _, site := range page.Sites {
+				r := resourceAwsNetworkManagerSite()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(site.SiteId))
+				d.Set("global_network_id", globalNetworkID)
+
+				log.Printf("[INFO] Deleting Network Manager Site: %s", d.Id())
+				if err := r.Delete(d, client); err != nil {
+					log.Printf("[ERROR] %s", err)
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Sites (%s): %w", globalNetworkID, err))
+		}
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSNetworkManagerSite_basic(t *testing.T) {
+	var v networkmanager.Site
+	resourceName := "aws_networkmanager_site.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerSiteConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerSiteExists(resourceName, &v),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`site/global-network-.+/site-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerSite_disappears(t *testing.T) {
+	var v networkmanager.Site
+	resourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerSiteConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerSiteExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerSite(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerSite_Location(t *testing.T) {
+	var v networkmanager.Site
+	resourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerSiteConfigLocation("description1", "Stuart, FL", "27.198", "-80.253"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerSiteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.address", "Stuart, FL"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.latitude", "27.198"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.longitude", "-80.253"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerSiteConfigLocation("description2", "Brisbane, QLD", "-27.470", "153.026"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerSiteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.address", "Brisbane, QLD"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.latitude", "-27.470"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.longitude", "153.026"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerSite_Tags(t *testing.T) {
+	var v networkmanager.Site
+	resourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerSiteConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerSiteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerSiteConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerSiteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSNetworkManagerSiteConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerSiteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerSiteExists(n string, v *networkmanager.Site) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Site ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		output, err := finder.SiteByTwoPartKey(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNetworkManagerSiteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_site" {
+			continue
+		}
+
+		_, err := finder.SiteByTwoPartKey(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Site %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkManagerSiteConfigBasic() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccAWSNetworkManagerSiteConfigLocation(description, address, latitude, longitude string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  description       = %[1]q
+
+  location {
+    address   = %[2]q
+    latitude  = %[3]q
+    longitude = %[4]q
+  }
+}
+`, description, address, latitude, longitude)
+}
+
+func testAccAWSNetworkManagerSiteConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSNetworkManagerSiteConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_transit_gateway_registration", &resource.Sweeper{
+		Name: "aws_networkmanager_transit_gateway_registration",
+		F:    testSweepNetworkManagerTransitGatewayRegistrations,
+	})
+}
+
+func testSweepNetworkManagerTransitGatewayRegistrations(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).networkmanagerconn
+	var sweeperErrs *multierror.Error
+
+	globalNetworkIDs, err := testSweepNetworkManagerGlobalNetworkIDs(conn)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Transit Gateway Registration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Global Networks: %w", err)
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		input := &networkmanager.GetTransitGatewayRegistrationsInput{
+			GlobalNetworkId: aws.String(globalNetworkID),
+		}
+
+		err := conn.GetTransitGatewayRegistrationsPages(input, func(page *networkmanager.GetTransitGatewayRegistrationsOutput, lastPage bool) bool {
+			for _, registration := range page.TransitGatewayRegistrations {
+				r := resourceAwsNetworkManagerTransitGatewayRegistration()
+				d := r.Data(nil)
+				d.SetId(tfnetworkmanager.TransitGatewayRegistrationCreateResourceID(globalNetworkID, aws.StringValue(registration.TransitGatewayArn)))
+
+				log.Printf("[INFO] Deleting Network Manager Transit Gateway Registration: %s", d.Id())
+				if err := r.Delete(d, client); err != nil {
+					log.Printf("[ERROR] %s", err)
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Transit Gateway Registrations (%s): %w", globalNetworkID, err))
+		}
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSNetworkManagerTransitGatewayRegistration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_transit_gateway_registration.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerTransitGatewayRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerTransitGatewayRegistrationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerTransitGatewayRegistrationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_arn", transitGatewayResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerTransitGatewayRegistration_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_networkmanager_transit_gateway_registration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerTransitGatewayRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerTransitGatewayRegistrationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerTransitGatewayRegistrationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerTransitGatewayRegistration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerTransitGatewayRegistrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Transit Gateway Registration ID is set")
+		}
+
+		globalNetworkID, transitGatewayARN, err := tfnetworkmanager.TransitGatewayRegistrationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		_, err = finder.TransitGatewayRegistrationByTwoPartKey(conn, globalNetworkID, transitGatewayARN)
+
+		return err
+	}
+}
+
+func testAccCheckAWSNetworkManagerTransitGatewayRegistrationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_transit_gateway_registration" {
+			continue
+		}
+
+		globalNetworkID, transitGatewayARN, err := tfnetworkmanager.TransitGatewayRegistrationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.TransitGatewayRegistrationByTwoPartKey(conn, globalNetworkID, transitGatewayARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Transit Gateway Registration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSNetworkManagerTransitGatewayRegistrationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_transit_gateway_registration" "test" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  transit_gateway_arn = aws_ec2_transit_gateway.test.arn
+}
+`, rName)
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/readcache"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 // How long to sleep if a limit-exceeded event happens
@@ -322,7 +324,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("destination_cidr_block"); ok {
 		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), v.(string), "")
+			route, err = resourceAwsRouteFindRoute(conn, nil, d.Get("route_table_id").(string), v.(string), "")
 			if err == nil {
 				if route != nil {
 					return nil
@@ -334,7 +336,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 			return resource.RetryableError(err)
 		})
 		if isResourceTimeoutError(err) {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), v.(string), "")
+			route, err = resourceAwsRouteFindRoute(conn, nil, d.Get("route_table_id").(string), v.(string), "")
 		}
 		if err != nil {
 			return fmt.Errorf("Error finding route after creating it: %s", err)
@@ -346,7 +348,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
 		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), "", v.(string))
+			route, err = resourceAwsRouteFindRoute(conn, nil, d.Get("route_table_id").(string), "", v.(string))
 			if err == nil {
 				if route != nil {
 					return nil
@@ -358,7 +360,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 			return resource.RetryableError(err)
 		})
 		if isResourceTimeoutError(err) {
-			route, err = resourceAwsRouteFindRoute(conn, d.Get("route_table_id").(string), "", v.(string))
+			route, err = resourceAwsRouteFindRoute(conn, nil, d.Get("route_table_id").(string), "", v.(string))
 		}
 		if err != nil {
 			return fmt.Errorf("Error finding route after creating it: %s", err)
@@ -375,12 +377,13 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	readCache := meta.(*AWSClient).readCache
 
 	routeTableId := d.Get("route_table_id").(string)
 	destinationCidrBlock := d.Get("destination_cidr_block").(string)
 	destinationIpv6CidrBlock := d.Get("destination_ipv6_cidr_block").(string)
 
-	route, err := resourceAwsRouteFindRoute(conn, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock)
+	route, err := resourceAwsRouteFindRoute(conn, readCache, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock)
 	if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
 		log.Printf("[WARN] Route Table (%s) not found, removing from state", routeTableId)
 		d.SetId("")
@@ -580,24 +583,18 @@ func resourceAwsRouteID(d *schema.ResourceData, r *ec2.Route) string {
 
 // resourceAwsRouteFindRoute returns any route whose destination is the specified IPv4 or IPv6 CIDR block.
 // Returns nil if the route table exists but no matching destination is found.
-func resourceAwsRouteFindRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string) (*ec2.Route, error) {
-	routeTableID := rtbid
-
-	findOpts := &ec2.DescribeRouteTablesInput{
-		RouteTableIds: []*string{&routeTableID},
-	}
-
-	resp, err := conn.DescribeRouteTables(findOpts)
+func resourceAwsRouteFindRoute(conn *ec2.EC2, cache *readcache.Cache, rtbid string, cidr string, ipv6cidr string) (*ec2.Route, error) {
+	routeTable, err := finder.RouteTableByIDWithCache(conn, cache, rtbid)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.RouteTables) < 1 || resp.RouteTables[0] == nil {
+	if routeTable == nil {
 		return nil, nil
 	}
 
 	if cidr != "" {
-		for _, route := range (*resp.RouteTables[0]).Routes {
+		for _, route := range routeTable.Routes {
 			if route.DestinationCidrBlock != nil && *route.DestinationCidrBlock == cidr {
 				return route, nil
 			}
@@ -607,7 +604,7 @@ func resourceAwsRouteFindRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cid
 	}
 
 	if ipv6cidr != "" {
-		for _, route := range (*resp.RouteTables[0]).Routes {
+		for _, route := range routeTable.Routes {
 			if cidrBlocksEqual(aws.StringValue(route.DestinationIpv6CidrBlock), ipv6cidr) {
 				return route, nil
 			}
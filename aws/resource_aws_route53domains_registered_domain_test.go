@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSRoute53DomainsRegisteredDomain_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"tags":           testAccAWSRoute53DomainsRegisteredDomain_tags,
+		"autoRenew":      testAccAWSRoute53DomainsRegisteredDomain_autoRenew,
+		"contactPrivacy": testAccAWSRoute53DomainsRegisteredDomain_contactPrivacy,
+		"transferLock":   testAccAWSRoute53DomainsRegisteredDomain_transferLock,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccPreCheckRoute53DomainsRegisteredDomain(t *testing.T) {
+	if os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME") == "" {
+		t.Skip("Environment variable ROUTE53DOMAINS_DOMAIN_NAME is not set")
+	}
+
+	testAccPartitionHasServicePreCheck(route53domains.EndpointsID, t)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_tags(t *testing.T) {
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					testAccCheckResourceAttrRfc3339(resourceName, "creation_date"),
+					testAccCheckResourceAttrRfc3339(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "registrar_name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags2(domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_autoRenew(t *testing.T) {
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_contactPrivacy(t *testing.T) {
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tech_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53DomainsRegisteredDomain_transferLock(t *testing.T) {
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53DomainsRegisteredDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Registered domains are never deleted, only removed from state.
+func testAccCheckAWSRoute53DomainsRegisteredDomainDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, domainName, tagKey1, tagValue1)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTags2(domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+  auto_renew  = %[2]t
+}
+`, domainName, autoRenew)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName string, privacy bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  admin_privacy      = %[2]t
+  registrant_privacy = %[2]t
+  tech_privacy       = %[2]t
+}
+`, domainName, privacy)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName string, transferLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name   = %[1]q
+  transfer_lock = %[2]t
+}
+`, domainName, transferLock)
+}
@@ -543,6 +543,7 @@ func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
 		r, err := resourceAwsRouteFindRoute(
 			conn,
+			nil,
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_cidr_block"],
 			rs.Primary.Attributes["destination_ipv6_cidr_block"],
@@ -571,6 +572,7 @@ func testAccCheckAWSRouteDestroy(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).ec2conn
 		route, err := resourceAwsRouteFindRoute(
 			conn,
+			nil,
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_cidr_block"],
 			rs.Primary.Attributes["destination_ipv6_cidr_block"],
@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/readcache"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 func resourceAwsSecurityGroupRule() *schema.Resource {
@@ -152,7 +154,7 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	awsMutexKV.Lock(sg_id)
 	defer awsMutexKV.Unlock(sg_id)
 
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 	if err != nil {
 		return err
 	}
@@ -227,7 +229,7 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 	log.Printf("[DEBUG] Computed group rule ID %s", id)
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		sg, err := findResourceSecurityGroup(conn, sg_id)
+		sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 
 		if err != nil {
 			log.Printf("[DEBUG] Error finding Security Group (%s) for Rule (%s): %s", sg_id, id, err)
@@ -252,7 +254,7 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 		return nil
 	})
 	if isResourceTimeoutError(err) {
-		sg, err := findResourceSecurityGroup(conn, sg_id)
+		sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 		if err != nil {
 			return fmt.Errorf("Error finding security group: %s", err)
 		}
@@ -279,8 +281,9 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 
 func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	readCache := meta.(*AWSClient).readCache
 	sg_id := d.Get("security_group_id").(string)
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, readCache, sg_id)
 	if _, notFound := err.(securityGroupNotFound); notFound {
 		// The security group containing this rule no longer exists.
 		d.SetId("")
@@ -360,7 +363,7 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	awsMutexKV.Lock(sg_id)
 	defer awsMutexKV.Unlock(sg_id)
 
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 	if err != nil {
 		return err
 	}
@@ -407,25 +410,19 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func findResourceSecurityGroup(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
-	req := &ec2.DescribeSecurityGroupsInput{
-		GroupIds: []*string{aws.String(id)},
-	}
-	resp, err := conn.DescribeSecurityGroups(req)
+func findResourceSecurityGroup(conn *ec2.EC2, cache *readcache.Cache, id string) (*ec2.SecurityGroup, error) {
+	sg, err := finder.SecurityGroupByIDWithCache(conn, cache, id)
 	if err, ok := err.(awserr.Error); ok && err.Code() == "InvalidGroup.NotFound" {
 		return nil, securityGroupNotFound{id, nil}
 	}
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
+	if sg == nil {
 		return nil, securityGroupNotFound{id, nil}
 	}
-	if len(resp.SecurityGroups) != 1 || resp.SecurityGroups[0] == nil {
-		return nil, securityGroupNotFound{id, resp.SecurityGroups}
-	}
 
-	return resp.SecurityGroups[0], nil
+	return sg, nil
 }
 
 type securityGroupNotFound struct {
@@ -899,7 +896,7 @@ func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.Resourc
 	awsMutexKV.Lock(sg_id)
 	defer awsMutexKV.Unlock(sg_id)
 
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, nil, sg_id)
 	if err != nil {
 		return err
 	}
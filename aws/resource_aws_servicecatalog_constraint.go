@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogConstraintCreate,
+		Read:   resourceAwsServiceCatalogConstraintRead,
+		Update: resourceAwsServiceCatalogConstraintUpdate,
+		Delete: resourceAwsServiceCatalogConstraintDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tfservicecatalog.AcceptLanguageEnglish,
+				ValidateFunc: validation.StringInSlice(tfservicecatalog.AcceptLanguage_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2000),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(tfservicecatalog.ConstraintType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	input := &servicecatalog.CreateConstraintInput{
+		AcceptLanguage:   aws.String(acceptLanguage),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       aws.String(d.Get("parameters").(string)),
+		PortfolioId:      aws.String(d.Get("portfolio_id").(string)),
+		ProductId:        aws.String(d.Get("product_id").(string)),
+		Type:             aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Constraint: %s", input)
+	output, err := conn.CreateConstraint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog %s Constraint: %w", d.Get("type").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.ConstraintDetail.ConstraintId))
+
+	if _, err := waiter.ConstraintReady(conn, acceptLanguage, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Constraint (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	output, err := finder.ConstraintByID(conn, d.Get("accept_language").(string), d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog Constraint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Constraint (%s): %w", d.Id(), err)
+	}
+
+	detail := output.ConstraintDetail
+
+	d.Set("description", detail.Description)
+	d.Set("owner", detail.Owner)
+	d.Set("parameters", output.ConstraintParameters)
+	d.Set("portfolio_id", detail.PortfolioId)
+	d.Set("product_id", detail.ProductId)
+	d.Set("status", output.Status)
+	d.Set("type", detail.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	if d.HasChanges("description", "parameters") {
+		acceptLanguage := d.Get("accept_language").(string)
+		input := &servicecatalog.UpdateConstraintInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			Id:             aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("parameters") {
+			input.Parameters = aws.String(d.Get("parameters").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog Constraint: %s", input)
+		_, err := conn.UpdateConstraint(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Service Catalog Constraint (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.ConstraintReady(conn, acceptLanguage, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Constraint (%s) to become available: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	log.Printf("[DEBUG] Deleting Service Catalog Constraint: %s", d.Id())
+	_, err := conn.DeleteConstraint(&servicecatalog.DeleteConstraintInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		Id:             aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Constraint (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
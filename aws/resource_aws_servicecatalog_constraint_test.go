@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogConstraint_basic(t *testing.T) {
+	var constraint servicecatalog.DescribeConstraintOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_constraint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogConstraintConfigLaunch(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName, &constraint),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters"),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "type", tfservicecatalog.ConstraintTypeLaunch),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogConstraintConfigLaunch(rName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName, &constraint),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogConstraint_disappears(t *testing.T) {
+	var constraint servicecatalog.DescribeConstraintOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_constraint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogConstraintConfigLaunch(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName, &constraint),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogConstraint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogConstraintExists(n string, v *servicecatalog.DescribeConstraintOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Constraint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		output, err := finder.ConstraintByID(conn, tfservicecatalog.AcceptLanguageEnglish, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogConstraintDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_constraint" {
+			continue
+		}
+
+		_, err := finder.ConstraintByID(conn, tfservicecatalog.AcceptLanguageEnglish, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Constraint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogConstraintConfigLaunch(rName, description string) string {
+	return composeConfig(testAccAWSServiceCatalogProductPortfolioAssociationConfigBasic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "servicecatalog.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_servicecatalog_constraint" "test" {
+  description  = %[2]q
+  portfolio_id = aws_servicecatalog_product_portfolio_association.test.portfolio_id
+  product_id   = aws_servicecatalog_product_portfolio_association.test.product_id
+  type         = "LAUNCH"
+
+  parameters = jsonencode({
+    RoleArn = aws_iam_role.test.arn
+  })
+}
+`, rName, description))
+}
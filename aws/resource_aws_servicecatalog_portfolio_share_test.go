@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogPortfolioShare_basic(t *testing.T) {
+	var providers []*schema.Provider
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_portfolio_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfigAccount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPortfolioShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accepted", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_caller_identity.alternate", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.OrganizationNodeTypeAccount),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogPortfolioShare_Organization(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_portfolio_share.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfigOrganization(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPortfolioShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_organizations_organization.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.OrganizationNodeTypeOrganization),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Portfolio Share ID is set")
+		}
+
+		portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err = finder.PortfolioShareByThreePartKey(conn, portfolioID, shareType, principalID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_portfolio_share" {
+			continue
+		}
+
+		portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.PortfolioShareByThreePartKey(conn, portfolioID, shareType, principalID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Portfolio Share %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogPortfolioShareConfigAccount(rName string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = substr(%[1]q, 0, 20)
+  description   = %[1]q
+  provider_name = "tf-acc-test"
+}
+
+resource "aws_servicecatalog_portfolio_share" "test" {
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  principal_id = data.aws_caller_identity.alternate.account_id
+  type         = "ACCOUNT"
+}
+`, rName))
+}
+
+func testAccAWSServiceCatalogPortfolioShareConfigOrganization(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = substr(%[1]q, 0, 20)
+  description   = %[1]q
+  provider_name = "tf-acc-test"
+}
+
+resource "aws_servicecatalog_portfolio_share" "test" {
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  principal_id = data.aws_organizations_organization.test.id
+  type         = "ORGANIZATION"
+}
+`, rName)
+}
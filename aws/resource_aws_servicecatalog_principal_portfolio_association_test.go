@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogPrincipalPortfolioAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_principal_portfolio_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPrincipalPortfolioAssociationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", servicecatalog.PrincipalTypeIam),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogPrincipalPortfolioAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_principal_portfolio_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPrincipalPortfolioAssociationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogPrincipalPortfolioAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Principal Portfolio Association ID is set")
+		}
+
+		portfolioID, principalARN, err := tfservicecatalog.PrincipalPortfolioAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err = finder.PrincipalPortfolioAssociationByTwoPartKey(conn, tfservicecatalog.AcceptLanguageEnglish, portfolioID, principalARN)
+
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_principal_portfolio_association" {
+			continue
+		}
+
+		portfolioID, principalARN, err := tfservicecatalog.PrincipalPortfolioAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.PrincipalPortfolioAssociationByTwoPartKey(conn, tfservicecatalog.AcceptLanguageEnglish, portfolioID, principalARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Principal Portfolio Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogPrincipalPortfolioAssociationConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "servicecatalog.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = substr(%[1]q, 0, 20)
+  description   = %[1]q
+  provider_name = "tf-acc-test"
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = aws_servicecatalog_portfolio.test.id
+  principal_arn = aws_iam_role.test.arn
+}
+`, rName)
+}
@@ -0,0 +1,387 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductCreate,
+		Read:   resourceAwsServiceCatalogProductRead,
+		Update: resourceAwsServiceCatalogProductUpdate,
+		Delete: resourceAwsServiceCatalogProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tfservicecatalog.AcceptLanguageEnglish,
+				ValidateFunc: validation.StringInSlice(tfservicecatalog.AcceptLanguage_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"distributor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"has_default_path": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8191),
+			},
+			"owner": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8191),
+			},
+			"provisioning_artifact_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 8191),
+						},
+						"disable_template_validation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 8191),
+						},
+						"template_physical_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+							ExactlyOneOf: []string{
+								"provisioning_artifact_parameters.0.template_physical_id",
+								"provisioning_artifact_parameters.0.template_url",
+							},
+						},
+						"template_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ExactlyOneOf: []string{
+								"provisioning_artifact_parameters.0.template_physical_id",
+								"provisioning_artifact_parameters.0.template_url",
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+							ValidateFunc: validation.StringInSlice(servicecatalog.ProvisioningArtifactType_Values(), false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"support_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 254),
+			},
+			"support_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2083),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(servicecatalog.ProductType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	acceptLanguage := d.Get("accept_language").(string)
+	input := &servicecatalog.CreateProductInput{
+		AcceptLanguage:   aws.String(acceptLanguage),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Name:             aws.String(d.Get("name").(string)),
+		Owner:            aws.String(d.Get("owner").(string)),
+		ProductType:      aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distributor"); ok {
+		input.Distributor = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_artifact_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ProvisioningArtifactParameters = expandServiceCatalogProvisioningArtifactProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("support_description"); ok {
+		input.SupportDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_email"); ok {
+		input.SupportEmail = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_url"); ok {
+		input.SupportUrl = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ServicecatalogTags()
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product: %s", input)
+	output, err := conn.CreateProduct(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Product (%s): %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.ProductViewDetail.ProductViewSummary.ProductId))
+
+	if _, err := waiter.ProductReady(conn, acceptLanguage, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Product (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.ProductByID(conn, d.Get("accept_language").(string), d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product (%s): %w", d.Id(), err)
+	}
+
+	detail := output.ProductViewDetail
+	summary := detail.ProductViewSummary
+
+	d.Set("arn", detail.ProductARN)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(detail.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", summary.ShortDescription)
+	d.Set("distributor", summary.Distributor)
+	d.Set("has_default_path", summary.HasDefaultPath)
+	d.Set("name", summary.Name)
+	d.Set("owner", summary.Owner)
+	d.Set("status", detail.Status)
+	d.Set("support_description", summary.SupportDescription)
+	d.Set("support_email", summary.SupportEmail)
+	d.Set("support_url", summary.SupportUrl)
+	d.Set("type", summary.Type)
+
+	tags := keyvaluetags.ServicecatalogKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	if d.HasChangesExcept("accept_language") {
+		input := &servicecatalog.UpdateProductInput{
+			AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+			Id:             aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("distributor") {
+			input.Distributor = aws.String(d.Get("distributor").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("owner") {
+			input.Owner = aws.String(d.Get("owner").(string))
+		}
+
+		if d.HasChange("support_description") {
+			input.SupportDescription = aws.String(d.Get("support_description").(string))
+		}
+
+		if d.HasChange("support_email") {
+			input.SupportEmail = aws.String(d.Get("support_email").(string))
+		}
+
+		if d.HasChange("support_url") {
+			input.SupportUrl = aws.String(d.Get("support_url").(string))
+		}
+
+		if d.HasChange("tags_all") {
+			o, n := d.GetChange("tags_all")
+			oldTags := keyvaluetags.New(o).IgnoreAws()
+			newTags := keyvaluetags.New(n).IgnoreAws()
+
+			if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+				input.RemoveTags = aws.StringSlice(removedTags.Keys())
+			}
+
+			if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+				input.AddTags = updatedTags.ServicecatalogTags()
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog Product: %s", input)
+		_, err := conn.UpdateProduct(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Service Catalog Product (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	log.Printf("[DEBUG] Deleting Service Catalog Product: %s", d.Id())
+	_, err := conn.DeleteProduct(&servicecatalog.DeleteProductInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		Id:             aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Product (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceCatalogProvisioningArtifactProperties(tfMap map[string]interface{}) *servicecatalog.ProvisioningArtifactProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &servicecatalog.ProvisioningArtifactProperties{
+		Info: map[string]*string{},
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["disable_template_validation"].(bool); ok && v {
+		apiObject.DisableTemplateValidation = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_physical_id"].(string); ok && v != "" {
+		apiObject.Info["ImportFromPhysicalId"] = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_url"].(string); ok && v != "" {
+		apiObject.Info["LoadTemplateFromURL"] = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogProductPortfolioAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product_portfolio_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductPortfolioAssociationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductPortfolioAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProductPortfolioAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product_portfolio_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductPortfolioAssociationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductPortfolioAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProductPortfolioAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductPortfolioAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Product Portfolio Association ID is set")
+		}
+
+		portfolioID, productID, err := tfservicecatalog.ProductPortfolioAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err = finder.ProductPortfolioAssociationByTwoPartKey(conn, tfservicecatalog.AcceptLanguageEnglish, portfolioID, productID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductPortfolioAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product_portfolio_association" {
+			continue
+		}
+
+		portfolioID, productID, err := tfservicecatalog.ProductPortfolioAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ProductPortfolioAssociationByTwoPartKey(conn, tfservicecatalog.AcceptLanguageEnglish, portfolioID, productID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Product Portfolio Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProductPortfolioAssociationConfigBase(rName string) string {
+	return composeConfig(testAccAWSServiceCatalogProductConfigBasic(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = substr(%[1]q, 0, 20)
+  description   = %[1]q
+  provider_name = "tf-acc-test"
+}
+`, rName))
+}
+
+func testAccAWSServiceCatalogProductPortfolioAssociationConfigBasic(rName string) string {
+	return composeConfig(testAccAWSServiceCatalogProductPortfolioAssociationConfigBase(rName), `
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  product_id   = aws_servicecatalog_product.test.id
+}
+`)
+}
@@ -0,0 +1,336 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogProduct_basic(t *testing.T) {
+	var product servicecatalog.DescribeProductAsAdminOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", tfservicecatalog.AcceptLanguageEnglish),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "catalog", regexp.MustCompile(`product/prod-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "distributor", "distributor"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner", "owner"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_artifact_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "support_description", "support description"),
+					resource.TestCheckResourceAttr(resourceName, "support_email", "support@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "support_url", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.ProductTypeCloudFormationTemplate),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provisioning_artifact_parameters"},
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProduct_disappears(t *testing.T) {
+	var product servicecatalog.DescribeProductAsAdminOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProduct(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProduct_Tags(t *testing.T) {
+	var product servicecatalog.DescribeProductAsAdminOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provisioning_artifact_parameters"},
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProduct_update(t *testing.T) {
+	var product servicecatalog.DescribeProductAsAdminOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner", "owner"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfigUpdated(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "owner", "updated owner"),
+					resource.TestCheckResourceAttr(resourceName, "support_email", "support2@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductExists(n string, v *servicecatalog.DescribeProductAsAdminOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		output, err := finder.ProductByID(conn, tfservicecatalog.AcceptLanguageEnglish, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product" {
+			continue
+		}
+
+		_, err := finder.ProductByID(conn, tfservicecatalog.AcceptLanguageEnglish, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Product %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSServiceCatalogProductConfigTemplateBase uploads a CloudFormation template that
+// creates nothing but an output, so that products based on it can be provisioned cheaply.
+func testAccAWSServiceCatalogProductConfigTemplateBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "%[1]s.json"
+
+  content = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Parameters = {
+      VPCCidr = {
+        Type    = "String"
+        Default = "10.0.0.0/16"
+      }
+    }
+
+    Conditions = {
+      Never = {
+        "Fn::Equals" = ["a", "b"]
+      }
+    }
+
+    Resources = {
+      NullResource = {
+        Type      = "AWS::CloudFormation::WaitConditionHandle"
+        Condition = "Never"
+      }
+    }
+
+    Outputs = {
+      VpcCidr = {
+        Description = "CIDR of the VPC that would be created"
+        Value       = { Ref = "VPCCidr" }
+      }
+    }
+  })
+}
+`, rName)
+}
+
+func testAccAWSServiceCatalogProductConfigBasic(rName string) string {
+	return composeConfig(testAccAWSServiceCatalogProductConfigTemplateBase(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  description         = "description"
+  distributor         = "distributor"
+  name                = %[1]q
+  owner               = "owner"
+  type                = "CLOUD_FORMATION_TEMPLATE"
+  support_description = "support description"
+  support_email       = "support@example.com"
+  support_url         = "https://example.com"
+
+  provisioning_artifact_parameters {
+    description                 = "artifact description"
+    disable_template_validation = true
+    name                        = %[1]q
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+}
+`, rName))
+}
+
+func testAccAWSServiceCatalogProductConfigUpdated(rName, rNameUpdated string) string {
+	return composeConfig(testAccAWSServiceCatalogProductConfigTemplateBase(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  description         = "updated description"
+  distributor         = "distributor"
+  name                = %[2]q
+  owner               = "updated owner"
+  type                = "CLOUD_FORMATION_TEMPLATE"
+  support_description = "support description"
+  support_email       = "support2@example.com"
+  support_url         = "https://example.com"
+
+  provisioning_artifact_parameters {
+    description                 = "artifact description"
+    disable_template_validation = true
+    name                        = %[1]q
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+}
+`, rName, rNameUpdated))
+}
+
+func testAccAWSServiceCatalogProductConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSServiceCatalogProductConfigTemplateBase(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  name  = %[1]q
+  owner = "owner"
+  type  = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    disable_template_validation = true
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSServiceCatalogProductConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSServiceCatalogProductConfigTemplateBase(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  name  = %[1]q
+  owner = "owner"
+  type  = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    disable_template_validation = true
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
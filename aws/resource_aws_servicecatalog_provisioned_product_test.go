@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogProvisionedProduct_basic(t *testing.T) {
+	var provisionedProduct servicecatalog.ProvisionedProductDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisionedProductExists(resourceName, &provisionedProduct),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexp.MustCompile(`stack/.+/pp-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_record_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "outputs.*", map[string]string{
+						"key":   "VpcCidr",
+						"value": "10.1.0.0/16",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "type", "CFN_STACK"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_errors", "provisioning_parameters"},
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "10.2.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisionedProductExists(resourceName, &provisionedProduct),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "outputs.*", map[string]string{
+						"key":   "VpcCidr",
+						"value": "10.2.0.0/16",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProvisionedProduct_disappears(t *testing.T) {
+	var provisionedProduct servicecatalog.ProvisionedProductDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisionedProductExists(resourceName, &provisionedProduct),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProvisionedProduct(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProvisionedProductExists(n string, v *servicecatalog.ProvisionedProductDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Provisioned Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		output, err := finder.ProvisionedProductByID(conn, tfservicecatalog.AcceptLanguageEnglish, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProvisionedProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioned_product" {
+			continue
+		}
+
+		_, err := finder.ProvisionedProductByID(conn, tfservicecatalog.AcceptLanguageEnglish, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Provisioned Product %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, vpcCidr string) string {
+	return composeConfig(testAccAWSServiceCatalogProductPortfolioAssociationConfigBasic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = aws_servicecatalog_portfolio.test.id
+  principal_arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+}
+
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                     = %[1]q
+  product_id               = aws_servicecatalog_product_portfolio_association.test.product_id
+  provisioning_artifact_id = aws_servicecatalog_provisioning_artifact.test.provisioning_artifact_id
+
+  provisioning_parameters {
+    key   = "VPCCidr"
+    value = %[2]q
+  }
+
+  depends_on = [aws_servicecatalog_principal_portfolio_association.test]
+}
+`, rName, vpcCidr))
+}
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisioningArtifactCreate,
+		Read:   resourceAwsServiceCatalogProvisioningArtifactRead,
+		Update: resourceAwsServiceCatalogProvisioningArtifactUpdate,
+		Delete: resourceAwsServiceCatalogProvisioningArtifactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tfservicecatalog.AcceptLanguageEnglish,
+				ValidateFunc: validation.StringInSlice(tfservicecatalog.AcceptLanguage_Values(), false),
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"disable_template_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"guidance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      servicecatalog.ProvisioningArtifactGuidanceDefault,
+				ValidateFunc: validation.StringInSlice(servicecatalog.ProvisioningArtifactGuidance_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_physical_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+				ExactlyOneOf: []string{"template_physical_id", "template_url"},
+			},
+			"template_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_physical_id", "template_url"},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice(servicecatalog.ProvisioningArtifactType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	productID := d.Get("product_id").(string)
+	input := &servicecatalog.CreateProvisioningArtifactInput{
+		AcceptLanguage:   aws.String(acceptLanguage),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters: expandServiceCatalogProvisioningArtifactProperties(map[string]interface{}{
+			"description":                 d.Get("description"),
+			"disable_template_validation": d.Get("disable_template_validation"),
+			"name":                        d.Get("name"),
+			"template_physical_id":        d.Get("template_physical_id"),
+			"template_url":                d.Get("template_url"),
+			"type":                        d.Get("type"),
+		}),
+		ProductId: aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %s", input)
+	output, err := conn.CreateProvisioningArtifact(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Provisioning Artifact for Product (%s): %w", productID, err)
+	}
+
+	provisioningArtifactID := aws.StringValue(output.ProvisioningArtifactDetail.Id)
+	d.SetId(tfservicecatalog.ProvisioningArtifactCreateResourceID(productID, provisioningArtifactID))
+
+	if _, err := waiter.ProvisioningArtifactReady(conn, acceptLanguage, productID, provisioningArtifactID); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioning Artifact (%s) to become available: %w", d.Id(), err)
+	}
+
+	// Active and guidance can only be set after the provisioning artifact is created.
+	if !d.Get("active").(bool) || d.Get("guidance").(string) != servicecatalog.ProvisioningArtifactGuidanceDefault {
+		return resourceAwsServiceCatalogProvisioningArtifactUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.ProvisioningArtifactByTwoPartKey(conn, d.Get("accept_language").(string), productID, provisioningArtifactID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog Provisioning Artifact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): %w", d.Id(), err)
+	}
+
+	detail := output.ProvisioningArtifactDetail
+
+	d.Set("active", detail.Active)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(detail.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", detail.Description)
+	d.Set("guidance", detail.Guidance)
+	d.Set("name", detail.Name)
+	d.Set("product_id", productID)
+	d.Set("provisioning_artifact_id", detail.Id)
+	if v, ok := output.Info["TemplateUrl"]; ok {
+		d.Set("template_url", v)
+	}
+	d.Set("type", detail.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(d.Get("accept_language").(string)),
+		Active:                 aws.Bool(d.Get("active").(bool)),
+		Guidance:               aws.String(d.Get("guidance").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact: %s", input)
+	_, err = conn.UpdateProvisioningArtifact(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioning Artifact (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioning Artifact: %s", d.Id())
+	_, err = conn.DeleteProvisioningArtifact(&servicecatalog.DeleteProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(d.Get("accept_language").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Provisioning Artifact (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogProvisioningArtifact_basic(t *testing.T) {
+	var artifact servicecatalog.DescribeProvisioningArtifactOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProvisioningArtifactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisioningArtifactConfigBasic(rName, true, servicecatalog.ProvisioningArtifactGuidanceDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName, &artifact),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDefault),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-2", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_template_validation"},
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisioningArtifactConfigBasic(rName, false, servicecatalog.ProvisioningArtifactGuidanceDeprecated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName, &artifact),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDeprecated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProvisioningArtifact_disappears(t *testing.T) {
+	var artifact servicecatalog.DescribeProvisioningArtifactOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(servicecatalog.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProvisioningArtifactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisioningArtifactConfigBasic(rName, true, servicecatalog.ProvisioningArtifactGuidanceDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName, &artifact),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProvisioningArtifact(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProvisioningArtifactExists(n string, v *servicecatalog.DescribeProvisioningArtifactOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Provisioning Artifact ID is set")
+		}
+
+		productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		output, err := finder.ProvisioningArtifactByTwoPartKey(conn, tfservicecatalog.AcceptLanguageEnglish, productID, provisioningArtifactID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProvisioningArtifactDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioning_artifact" {
+			continue
+		}
+
+		productID, provisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ProvisioningArtifactByTwoPartKey(conn, tfservicecatalog.AcceptLanguageEnglish, productID, provisioningArtifactID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Provisioning Artifact %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProvisioningArtifactConfigBasic(rName string, active bool, guidance string) string {
+	return composeConfig(testAccAWSServiceCatalogProductConfigBasic(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  active                      = %[2]t
+  description                 = %[1]q
+  disable_template_validation = true
+  guidance                    = %[3]q
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, active, guidance))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,8 @@ func resourceAwsSnsTopic() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -125,14 +128,23 @@ func resourceAwsSnsTopic() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"region": regionSchema(),
+
 			"tags": tagsSchema(),
+
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
-	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().SnsTags()
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	snsconn := client.snsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{}))).IgnoreAws().SnsTags()
 	var name string
 	if v, ok := d.GetOk("name"); ok {
 		name = v.(string)
@@ -264,7 +276,11 @@ func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	snsconn := client.snsconn
 
 	// update mutable attributes
 	if d.HasChange("application_failure_feedback_role_arn") {
@@ -370,8 +386,8 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.SnsUpdateTags(snsconn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -381,7 +397,18 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	// The topic ARN holds the region for imported topics
+	region := d.Get("region").(string)
+	if v, err := arn.Parse(d.Id()); err == nil {
+		region = v.Region
+	}
+
+	client, err := meta.(*AWSClient).RegionalClient(region)
+	if err != nil {
+		return err
+	}
+	snsconn := client.snsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	log.Printf("[DEBUG] Reading SNS Topic Attributes for %s", d.Id())
@@ -398,6 +425,8 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	d.Set("region", client.region)
+
 	// set the mutable attributes
 	if attributeOutput.Attributes != nil && len(attributeOutput.Attributes) > 0 {
 		// set the string values
@@ -474,18 +503,29 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags for resource (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsSnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	snsconn := client.snsconn
 
 	log.Printf("[DEBUG] SNS Delete Topic: %s", d.Id())
-	_, err := snsconn.DeleteTopic(&sns.DeleteTopicInput{
+	_, err = snsconn.DeleteTopic(&sns.DeleteTopicInput{
 		TopicArn: aws.String(d.Id()),
 	})
 
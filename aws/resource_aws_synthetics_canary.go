@@ -0,0 +1,691 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsSyntheticsCanary() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSyntheticsCanaryCreate,
+		Read:   resourceAwsSyntheticsCanaryRead,
+		Update: resourceAwsSyntheticsCanaryUpdate,
+		Delete: resourceAwsSyntheticsCanaryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSyntheticsCanaryImport,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"artifact_s3_location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimPrefix(new, "s3://") == old
+				},
+			},
+			"engine_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"failure_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31,
+				ValidateFunc: validation.IntBetween(1, 455),
+			},
+			"handler": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 21),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_\-]+$`), "must contain only lowercase alphanumeric, hyphen, or underscore characters"),
+				),
+			},
+			"run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_tracing": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"environment_variables": {
+							Type:      schema.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem:      &schema.Schema{Type: schema.TypeString},
+						},
+						"memory_in_mb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.All(
+								validation.IntBetween(960, 3008),
+								validation.IntDivisibleBy(64),
+							),
+						},
+						"timeout_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(3, 840),
+						},
+					},
+				},
+			},
+			"runtime_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"s3_bucket": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+				RequiredWith:  []string{"s3_key"},
+			},
+			"s3_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+				RequiredWith:  []string{"s3_bucket"},
+			},
+			"s3_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"expression": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"source_code_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_location_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_canary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"success_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31,
+				ValidateFunc: validation.IntBetween(1, 455),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"timeline": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_started": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_stopped": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zip_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_version"},
+				ExactlyOneOf:  []string{"s3_bucket", "zip_file"},
+			},
+		},
+	}
+}
+
+func resourceAwsSyntheticsCanaryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &synthetics.CreateCanaryInput{
+		ArtifactS3Location:           aws.String(d.Get("artifact_s3_location").(string)),
+		ExecutionRoleArn:             aws.String(d.Get("execution_role_arn").(string)),
+		FailureRetentionPeriodInDays: aws.Int64(int64(d.Get("failure_retention_period").(int))),
+		Name:                         aws.String(name),
+		RuntimeVersion:               aws.String(d.Get("runtime_version").(string)),
+		Schedule:                     expandSyntheticsCanarySchedule(d.Get("schedule").([]interface{})),
+		SuccessRetentionPeriodInDays: aws.Int64(int64(d.Get("success_retention_period").(int))),
+	}
+
+	code, err := expandSyntheticsCanaryCode(d)
+
+	if err != nil {
+		return err
+	}
+
+	input.Code = code
+
+	if v, ok := d.GetOk("run_config"); ok {
+		input.RunConfig = expandSyntheticsCanaryRunConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandSyntheticsCanaryVpcConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().SyntheticsTags()
+	}
+
+	log.Printf("[INFO] Creating Synthetics Canary: %s", name)
+	_, err = conn.CreateCanary(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Synthetics Canary (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.CanaryReady(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) creation: %w", d.Id(), err)
+	}
+
+	if d.Get("start_canary").(bool) {
+		if err := syntheticsStartCanary(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSyntheticsCanaryRead(d, meta)
+}
+
+func resourceAwsSyntheticsCanaryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	canary, err := finder.CanaryByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Synthetics Canary (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	canaryArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "synthetics",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("canary:%s", aws.StringValue(canary.Name)),
+	}.String()
+	d.Set("arn", canaryArn)
+	d.Set("artifact_s3_location", canary.ArtifactS3Location)
+	d.Set("engine_arn", canary.EngineArn)
+	d.Set("execution_role_arn", canary.ExecutionRoleArn)
+	d.Set("failure_retention_period", canary.FailureRetentionPeriodInDays)
+	d.Set("name", canary.Name)
+	d.Set("runtime_version", canary.RuntimeVersion)
+	d.Set("success_retention_period", canary.SuccessRetentionPeriodInDays)
+
+	if canary.Code != nil {
+		d.Set("handler", canary.Code.Handler)
+		d.Set("source_location_arn", canary.Code.SourceLocationArn)
+	}
+
+	if canary.Status != nil {
+		d.Set("status", canary.Status.State)
+	}
+
+	if err := d.Set("run_config", flattenSyntheticsCanaryRunConfig(canary.RunConfig, d.Get("run_config").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting run_config: %w", err)
+	}
+
+	if err := d.Set("schedule", flattenSyntheticsCanarySchedule(canary.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %w", err)
+	}
+
+	if err := d.Set("timeline", flattenSyntheticsCanaryTimeline(canary.Timeline)); err != nil {
+		return fmt.Errorf("error setting timeline: %w", err)
+	}
+
+	if err := d.Set("vpc_config", flattenSyntheticsCanaryVpcConfig(canary.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	tags := keyvaluetags.SyntheticsKeyValueTags(canary.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSyntheticsCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	if d.HasChangesExcept("start_canary", "tags", "tags_all") {
+		input := &synthetics.UpdateCanaryInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChanges("handler", "s3_bucket", "s3_key", "s3_version", "source_code_hash", "zip_file") {
+			code, err := expandSyntheticsCanaryCode(d)
+
+			if err != nil {
+				return err
+			}
+
+			input.Code = code
+		}
+
+		if d.HasChange("execution_role_arn") {
+			input.ExecutionRoleArn = aws.String(d.Get("execution_role_arn").(string))
+		}
+
+		if d.HasChange("failure_retention_period") {
+			input.FailureRetentionPeriodInDays = aws.Int64(int64(d.Get("failure_retention_period").(int)))
+		}
+
+		if d.HasChange("run_config") {
+			input.RunConfig = expandSyntheticsCanaryRunConfig(d.Get("run_config").([]interface{}))
+		}
+
+		if d.HasChange("runtime_version") {
+			input.RuntimeVersion = aws.String(d.Get("runtime_version").(string))
+		}
+
+		if d.HasChange("schedule") {
+			input.Schedule = expandSyntheticsCanarySchedule(d.Get("schedule").([]interface{}))
+		}
+
+		if d.HasChange("success_retention_period") {
+			input.SuccessRetentionPeriodInDays = aws.Int64(int64(d.Get("success_retention_period").(int)))
+		}
+
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandSyntheticsCanaryVpcConfig(d.Get("vpc_config").([]interface{}))
+
+			// An empty configuration removes the canary from the VPC.
+			if input.VpcConfig == nil {
+				input.VpcConfig = &synthetics.VpcConfigInput{}
+			}
+		}
+
+		log.Printf("[INFO] Updating Synthetics Canary: %s", d.Id())
+		_, err := conn.UpdateCanary(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Synthetics Canary (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.CanaryUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Synthetics Canary (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_canary") {
+		if d.Get("start_canary").(bool) {
+			if err := syntheticsStartCanary(conn, d.Id()); err != nil {
+				return err
+			}
+		} else {
+			if err := syntheticsStopCanary(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.SyntheticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Synthetics Canary (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSyntheticsCanaryRead(d, meta)
+}
+
+func resourceAwsSyntheticsCanaryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	canary, err := finder.CanaryByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	// A running canary must be stopped before it can be deleted.
+	if canary.Status != nil && aws.StringValue(canary.Status.State) == synthetics.CanaryStateRunning {
+		if err := syntheticsStopCanary(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Deleting Synthetics Canary: %s", d.Id())
+	_, err = conn.DeleteCanary(&synthetics.DeleteCanaryInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.CanaryDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSyntheticsCanaryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	canary, err := finder.CanaryByName(conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	d.Set("start_canary", canary.Status != nil && aws.StringValue(canary.Status.State) == synthetics.CanaryStateRunning)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func syntheticsStartCanary(conn *synthetics.Synthetics, name string) error {
+	log.Printf("[INFO] Starting Synthetics Canary: %s", name)
+	_, err := conn.StartCanary(&synthetics.StartCanaryInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting Synthetics Canary (%s): %w", name, err)
+	}
+
+	if _, err := waiter.CanaryRunning(conn, name); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) start: %w", name, err)
+	}
+
+	return nil
+}
+
+func syntheticsStopCanary(conn *synthetics.Synthetics, name string) error {
+	log.Printf("[INFO] Stopping Synthetics Canary: %s", name)
+	_, err := conn.StopCanary(&synthetics.StopCanaryInput{
+		Name: aws.String(name),
+	})
+
+	// A canary that has already stopped, e.g. after a single run, cannot be
+	// stopped again.
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeConflictException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping Synthetics Canary (%s): %w", name, err)
+	}
+
+	if _, err := waiter.CanaryStopped(conn, name); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) stop: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandSyntheticsCanaryCode(d *schema.ResourceData) (*synthetics.CanaryCodeInput, error) {
+	code := &synthetics.CanaryCodeInput{
+		Handler: aws.String(d.Get("handler").(string)),
+	}
+
+	if v, ok := d.GetOk("zip_file"); ok {
+		file, err := loadFileContent(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error loading Synthetics Canary zip file (%s): %w", v.(string), err)
+		}
+
+		code.ZipFile = file
+
+		return code, nil
+	}
+
+	code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
+	code.S3Key = aws.String(d.Get("s3_key").(string))
+
+	if v, ok := d.GetOk("s3_version"); ok {
+		code.S3Version = aws.String(v.(string))
+	}
+
+	return code, nil
+}
+
+func expandSyntheticsCanaryRunConfig(l []interface{}) *synthetics.CanaryRunConfigInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	runConfig := &synthetics.CanaryRunConfigInput{
+		ActiveTracing: aws.Bool(tfMap["active_tracing"].(bool)),
+	}
+
+	if v, ok := tfMap["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		runConfig.EnvironmentVariables = stringMapToPointers(v)
+	}
+
+	if v, ok := tfMap["memory_in_mb"].(int); ok && v > 0 {
+		runConfig.MemoryInMB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v > 0 {
+		runConfig.TimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return runConfig
+}
+
+func expandSyntheticsCanarySchedule(l []interface{}) *synthetics.CanaryScheduleInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &synthetics.CanaryScheduleInput{
+		DurationInSeconds: aws.Int64(int64(tfMap["duration_in_seconds"].(int))),
+		Expression:        aws.String(tfMap["expression"].(string)),
+	}
+}
+
+func expandSyntheticsCanaryVpcConfig(l []interface{}) *synthetics.VpcConfigInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &synthetics.VpcConfigInput{
+		SecurityGroupIds: expandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+		SubnetIds:        expandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+	}
+}
+
+// flattenSyntheticsCanaryRunConfig flattens the canary's run configuration.
+// The environment variables are not returned by the API and are kept from
+// the configuration.
+func flattenSyntheticsCanaryRunConfig(runConfig *synthetics.CanaryRunConfigOutput, configured []interface{}) []interface{} {
+	if runConfig == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active_tracing":     aws.BoolValue(runConfig.ActiveTracing),
+		"memory_in_mb":       aws.Int64Value(runConfig.MemoryInMB),
+		"timeout_in_seconds": aws.Int64Value(runConfig.TimeoutInSeconds),
+	}
+
+	if len(configured) > 0 && configured[0] != nil {
+		tfMap["environment_variables"] = configured[0].(map[string]interface{})["environment_variables"]
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSyntheticsCanarySchedule(schedule *synthetics.CanaryScheduleOutput) []interface{} {
+	if schedule == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"duration_in_seconds": aws.Int64Value(schedule.DurationInSeconds),
+		"expression":          aws.StringValue(schedule.Expression),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSyntheticsCanaryTimeline(timeline *synthetics.CanaryTimeline) []interface{} {
+	if timeline == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if timeline.Created != nil {
+		tfMap["created"] = aws.TimeValue(timeline.Created).String()
+	}
+
+	if timeline.LastModified != nil {
+		tfMap["last_modified"] = aws.TimeValue(timeline.LastModified).String()
+	}
+
+	if timeline.LastStarted != nil {
+		tfMap["last_started"] = aws.TimeValue(timeline.LastStarted).String()
+	}
+
+	if timeline.LastStopped != nil {
+		tfMap["last_stopped"] = aws.TimeValue(timeline.LastStopped).String()
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSyntheticsCanaryVpcConfig(vpcConfig *synthetics.VpcConfigOutput) []interface{} {
+	if vpcConfig == nil || aws.StringValue(vpcConfig.VpcId) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": flattenStringSet(vpcConfig.SecurityGroupIds),
+		"subnet_ids":         flattenStringSet(vpcConfig.SubnetIds),
+		"vpc_id":             aws.StringValue(vpcConfig.VpcId),
+	}
+
+	return []interface{}{tfMap}
+}
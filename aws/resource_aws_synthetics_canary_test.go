@@ -0,0 +1,509 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
+		Name: "aws_synthetics_canary",
+		F:    testSweepSyntheticsCanaries,
+	})
+}
+
+func testSweepSyntheticsCanaries(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).syntheticsconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeCanariesPages(&synthetics.DescribeCanariesInput{}, func(page *synthetics.DescribeCanariesOutput, lastPage bool) bool {
+		for _, canary := range page.Canaries {
+			r := resourceAwsSyntheticsCanary()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(canary.Name))
+
+			log.Printf("[INFO] Deleting Synthetics Canary: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Synthetics Canaries: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSSyntheticsCanary_basic(t *testing.T) {
+	var canary synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "synthetics", fmt.Sprintf("canary:%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "artifact_s3_location", fmt.Sprintf("%s/", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "failure_retention_period", "31"),
+					resource.TestCheckResourceAttr(resourceName, "handler", "exports.handler"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.memory_in_mb", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "840"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", "syn-nodejs-2.2"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.duration_in_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.expression", "rate(0 hour)"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_location_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateReady),
+					resource.TestCheckResourceAttr(resourceName, "success_retention_period", "31"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_code_hash", "zip_file"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigZipFile(rName, "test-fixtures/synthetics_canary_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateReady),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_disappears(t *testing.T) {
+	var canary synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSyntheticsCanary(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_S3(t *testing.T) {
+	var canary synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", "aws_s3_bucket_object.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_version", "aws_s3_bucket_object.test", "version_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "s3_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_RunConfig(t *testing.T) {
+	var canary synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigRunConfig(rName, 60, 960, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.active_tracing", "true"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.memory_in_mb", "960"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "run_config.0.environment_variables"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigRunConfig(rName, 120, 1024, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.active_tracing", "false"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.memory_in_mb", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_StartCanary(t *testing.T) {
+	var canary synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigStartCanary(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttr(resourceName, "start_canary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateRunning),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.last_started"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigStartCanary(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttr(resourceName, "start_canary", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateStopped),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.last_stopped"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_Tags(t *testing.T) {
+	var canary synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSyntheticsCanaryExists(resourceName, &canary),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSyntheticsCanaryExists(resourceName string, canary *synthetics.Canary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Synthetics Canary ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+
+		output, err := finder.CanaryByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*canary = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSyntheticsCanaryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_synthetics_canary" {
+			continue
+		}
+
+		_, err := finder.CanaryByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Synthetics Canary %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSyntheticsCanaryConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject",
+        "s3:GetBucketLocation",
+        "s3:ListAllMyBuckets",
+        "cloudwatch:PutMetricData",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "xray:PutTraceSegments"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSSyntheticsCanaryConfigZipFile(rName, zipFile string) string {
+	return composeConfig(testAccAWSSyntheticsCanaryConfigBase(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = %[2]q
+  source_code_hash     = filebase64sha256(%[2]q)
+  runtime_version      = "syn-nodejs-2.2"
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, zipFile))
+}
+
+func testAccAWSSyntheticsCanaryConfigBasic(rName string) string {
+	return testAccAWSSyntheticsCanaryConfigZipFile(rName, "test-fixtures/synthetics_canary.zip")
+}
+
+func testAccAWSSyntheticsCanaryConfigS3(rName string) string {
+	return composeConfig(testAccAWSSyntheticsCanaryConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = %[1]q
+  source = "test-fixtures/synthetics_canary.zip"
+  etag   = filemd5("test-fixtures/synthetics_canary.zip")
+}
+
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  s3_bucket            = aws_s3_bucket_object.test.bucket
+  s3_key               = aws_s3_bucket_object.test.key
+  s3_version           = aws_s3_bucket_object.test.version_id
+  runtime_version      = "syn-nodejs-2.2"
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccAWSSyntheticsCanaryConfigRunConfig(rName string, timeout, memory int, activeTracing bool) string {
+	return composeConfig(testAccAWSSyntheticsCanaryConfigBase(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.2"
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  run_config {
+    active_tracing     = %[4]t
+    memory_in_mb       = %[3]d
+    timeout_in_seconds = %[2]d
+
+    environment_variables = {
+      TEST = "value"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, timeout, memory, activeTracing))
+}
+
+func testAccAWSSyntheticsCanaryConfigStartCanary(rName string, startCanary bool) string {
+	return composeConfig(testAccAWSSyntheticsCanaryConfigBase(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.2"
+  start_canary         = %[2]t
+
+  schedule {
+    expression = "rate(5 minutes)"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, startCanary))
+}
+
+func testAccAWSSyntheticsCanaryConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSSyntheticsCanaryConfigBase(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.2"
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
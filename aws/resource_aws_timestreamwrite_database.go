@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsTimestreamWriteDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTimestreamWriteDatabaseCreate,
+		Read:   resourceAwsTimestreamWriteDatabaseRead,
+		Update: resourceAwsTimestreamWriteDatabaseUpdate,
+		Delete: resourceAwsTimestreamWriteDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"table_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsTimestreamWriteDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("database_name").(string)
+	input := &timestreamwrite.CreateDatabaseInput{
+		DatabaseName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().TimestreamwriteTags()
+	}
+
+	log.Printf("[INFO] Creating Timestream Database: %s", input)
+	_, err := conn.CreateDatabase(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Timestream Database (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsTimestreamWriteDatabaseRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	database, err := finder.DatabaseByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream Database (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Timestream Database (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(database.Arn)
+	d.Set("arn", arn)
+	d.Set("database_name", database.DatabaseName)
+	d.Set("kms_key_id", database.KmsKeyId)
+	d.Set("table_count", database.TableCount)
+
+	tags, err := keyvaluetags.TimestreamwriteListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Database (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamWriteDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	if d.HasChange("kms_key_id") {
+		input := &timestreamwrite.UpdateDatabaseInput{
+			DatabaseName: aws.String(d.Id()),
+			KmsKeyId:     aws.String(d.Get("kms_key_id").(string)),
+		}
+
+		log.Printf("[INFO] Updating Timestream Database: %s", input)
+		_, err := conn.UpdateDatabase(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Timestream Database (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.TimestreamwriteUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Timestream Database (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsTimestreamWriteDatabaseRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	log.Printf("[INFO] Deleting Timestream Database: %s", d.Id())
+	_, err := conn.DeleteDatabase(&timestreamwrite.DeleteDatabaseInput{
+		DatabaseName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Timestream Database (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
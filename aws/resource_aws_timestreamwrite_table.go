@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsTimestreamWriteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTimestreamWriteTableCreate,
+		Read:   resourceAwsTimestreamWriteTableRead,
+		Update: resourceAwsTimestreamWriteTableUpdate,
+		Delete: resourceAwsTimestreamWriteTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"retention_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"magnetic_store_retention_period_in_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 73000),
+						},
+						"memory_store_retention_period_in_hours": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 8766),
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsTimestreamWriteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	databaseName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	input := &timestreamwrite.CreateTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	if v, ok := d.GetOk("retention_properties"); ok {
+		input.RetentionProperties = expandTimestreamWriteRetentionProperties(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().TimestreamwriteTags()
+	}
+
+	log.Printf("[INFO] Creating Timestream Table: %s", input)
+	_, err := conn.CreateTable(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Timestream Table (%s) in Database (%s): %w", tableName, databaseName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", tableName, databaseName))
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	tableName, databaseName, err := resourceAwsTimestreamWriteTableParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	table, err := finder.TableByName(conn, databaseName, tableName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(table.Arn)
+	d.Set("arn", arn)
+	d.Set("database_name", table.DatabaseName)
+
+	if err := d.Set("retention_properties", flattenTimestreamWriteRetentionProperties(table.RetentionProperties)); err != nil {
+		return fmt.Errorf("error setting retention_properties: %w", err)
+	}
+
+	d.Set("table_name", table.TableName)
+
+	tags, err := keyvaluetags.TimestreamwriteListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamWriteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	if d.HasChange("retention_properties") {
+		tableName, databaseName, err := resourceAwsTimestreamWriteTableParseID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &timestreamwrite.UpdateTableInput{
+			DatabaseName:        aws.String(databaseName),
+			RetentionProperties: expandTimestreamWriteRetentionProperties(d.Get("retention_properties").([]interface{})),
+			TableName:           aws.String(tableName),
+		}
+
+		log.Printf("[INFO] Updating Timestream Table: %s", input)
+		_, err = conn.UpdateTable(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.TimestreamwriteUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	tableName, databaseName, err := resourceAwsTimestreamWriteTableParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Timestream Table: %s", d.Id())
+	_, err = conn.DeleteTable(&timestreamwrite.DeleteTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	// The database cannot be deleted until its tables are gone.
+	if _, err := waiter.TableDeleted(conn, databaseName, tableName); err != nil {
+		return fmt.Errorf("error waiting for Timestream Table (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamWriteTableParseID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, ":", 2)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected table_name:database_name", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func expandTimestreamWriteRetentionProperties(l []interface{}) *timestreamwrite.RetentionProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &timestreamwrite.RetentionProperties{
+		MagneticStoreRetentionPeriodInDays: aws.Int64(int64(tfMap["magnetic_store_retention_period_in_days"].(int))),
+		MemoryStoreRetentionPeriodInHours:  aws.Int64(int64(tfMap["memory_store_retention_period_in_hours"].(int))),
+	}
+}
+
+func flattenTimestreamWriteRetentionProperties(retentionProperties *timestreamwrite.RetentionProperties) []interface{} {
+	if retentionProperties == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"magnetic_store_retention_period_in_days": aws.Int64Value(retentionProperties.MagneticStoreRetentionPeriodInDays),
+		"memory_store_retention_period_in_hours":  aws.Int64Value(retentionProperties.MemoryStoreRetentionPeriodInHours),
+	}
+
+	return []interface{}{tfMap}
+}
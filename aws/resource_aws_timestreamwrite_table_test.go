@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+		Name: "aws_timestreamwrite_table",
+		F:    testSweepTimestreamWriteTables,
+	})
+}
+
+func testSweepTimestreamWriteTables(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).timestreamwriteconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListTablesPages(&timestreamwrite.ListTablesInput{}, func(page *timestreamwrite.ListTablesOutput, lastPage bool) bool {
+		for _, table := range page.Tables {
+			r := resourceAwsTimestreamWriteTable()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(table.TableName), aws.StringValue(table.DatabaseName)))
+
+			log.Printf("[INFO] Deleting Timestream Table: %s", d.Id())
+			if err := r.Delete(d, client); err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Timestream Table sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Timestream Tables: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSTimestreamWriteTable_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(timestreamwrite.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "timestream", fmt.Sprintf("database/%[1]s/table/%[1]s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "database_name", "aws_timestreamwrite_database.test", "database_name"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSTimestreamWriteTable_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(timestreamwrite.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsTimestreamWriteTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSTimestreamWriteTable_RetentionProperties(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(timestreamwrite.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfigRetentionProperties(rName, 30, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.magnetic_store_retention_period_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.memory_store_retention_period_in_hours", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSTimestreamWriteTableConfigRetentionProperties(rName, 300, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.magnetic_store_retention_period_in_days", "300"),
+					resource.TestCheckResourceAttr(resourceName, "retention_properties.0.memory_store_retention_period_in_hours", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSTimestreamWriteTable_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(timestreamwrite.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSTimestreamWriteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSTimestreamWriteTableConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSTimestreamWriteTableConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSTimestreamWriteTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSTimestreamWriteTableExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Timestream Table ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+
+		tableName, databaseName, err := resourceAwsTimestreamWriteTableParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.TableByName(conn, databaseName, tableName)
+
+		return err
+	}
+}
+
+func testAccCheckAWSTimestreamWriteTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_timestreamwrite_table" {
+			continue
+		}
+
+		tableName, databaseName, err := resourceAwsTimestreamWriteTableParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.TableByName(conn, databaseName, tableName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Timestream Table %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSTimestreamWriteTableConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSTimestreamWriteTableConfigBasic(rName string) string {
+	return composeConfig(testAccAWSTimestreamWriteTableConfigBase(rName), fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+}
+`, rName))
+}
+
+func testAccAWSTimestreamWriteTableConfigRetentionProperties(rName string, magneticDays, memoryHours int) string {
+	return composeConfig(testAccAWSTimestreamWriteTableConfigBase(rName), fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  retention_properties {
+    magnetic_store_retention_period_in_days = %[2]d
+    memory_store_retention_period_in_hours  = %[3]d
+  }
+}
+`, rName, magneticDays, memoryHours))
+}
+
+func testAccAWSTimestreamWriteTableConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSTimestreamWriteTableConfigBase(rName), fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
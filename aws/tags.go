@@ -1,6 +1,12 @@
 package aws
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +32,17 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// tagsSchemaTrulyComputed returns the schema to use for the computed
+// tags_all attribute, which holds the merge of the provider default_tags
+// and the resource tags.
+func tagsSchemaTrulyComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
 func tagsSchemaForceNew() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -44,6 +61,67 @@ func tagsSchemaConflictsWith(conflictsWith []string) *schema.Schema {
 	}
 }
 
+// SetTagsDiff sets the new plan difference of the tags_all attribute to the
+// merge of the provider default_tags and the resource tags.
+// Resources supporting default_tags should include this function in their
+// CustomizeDiff and use tagsSchemaTrulyComputed() for tags_all.
+func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	if !diff.NewValueKnown("tags") {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("error setting tags_all to computed: %w", err)
+		}
+
+		return nil
+	}
+
+	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
+	if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
+		return fmt.Errorf("error setting new tags_all diff: %w", err)
+	}
+
+	return nil
+}
+
+// requiredTagsCustomizeDiff returns a CustomizeDiffFunc which checks the merge
+// of the provider default_tags and the resource tags against the provider
+// required_tags for the given resource type.
+func requiredTagsCustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+		requiredTagsConfig := meta.(*AWSClient).RequiredTagsConfig
+
+		if requiredTagsConfig.IsExempt(resourceType) {
+			return nil
+		}
+
+		// Unknown values are checked once known.
+		if !diff.NewValueKnown("tags") {
+			return nil
+		}
+
+		tags := defaultTagsConfig.MergeTags(keyvaluetags.New(diff.Get("tags").(map[string]interface{})))
+		violations := requiredTagsConfig.Violations(tags)
+
+		if len(violations) == 0 {
+			return nil
+		}
+
+		message := fmt.Sprintf("%s does not satisfy provider required_tags: %s", resourceType, strings.Join(violations, "; "))
+
+		if requiredTagsConfig.Enforcement == keyvaluetags.RequiredEnforcementWarn {
+			log.Printf("[WARN] %s", message)
+			return nil
+		}
+
+		return errors.New(message)
+	}
+}
+
 // ec2TagsFromTagDescriptions returns the tags from the given tag descriptions.
 // No attempt is made to remove duplicates.
 func ec2TagsFromTagDescriptions(tds []*ec2.TagDescription) []*ec2.Tag {
@@ -62,6 +140,20 @@ func ec2TagsFromTagDescriptions(tds []*ec2.TagDescription) []*ec2.Tag {
 	return tags
 }
 
+// ec2TagSpecificationsFromKeyValueTags returns the tag specifications for the given KeyValueTags object and resource type.
+func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t string) []*ec2.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(t),
+			Tags:         tags.IgnoreAws().Ec2Tags(),
+		},
+	}
+}
+
 // ec2TagSpecificationsFromMap returns the tag specifications for the given tag key/value map and resource type.
 func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagSpecification {
 	if len(m) == 0 {
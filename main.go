@@ -24,8 +24,12 @@ func main() {
 			log.Fatal(err.Error())
 		}
 
+		aws.LogThrottleSummary()
+
 		return
 	}
 
 	plugin.Serve(opts)
+
+	aws.LogThrottleSummary()
 }